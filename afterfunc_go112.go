@@ -0,0 +1,28 @@
+//go:build !go1.21
+// +build !go1.21
+
+package gsysint
+
+import (
+	"context"
+	"time"
+)
+
+// watchContext ends the park of generation gen with ParkCancelled once
+// ctx is done, unless stop is called first. Before context.AfterFunc a
+// goroutine has to wait for ctx.Done, but for a deadline: the timer of
+// ParkTimeout waits for it.
+func watchContext(p *Park, gen uint32, ctx context.Context) (stop func()) {
+	if deadline, ok := ctx.Deadline(); ok {
+		return startParkTimer(p, gen, time.Until(deadline), ParkCancelled).stop
+	}
+	stopc := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			p.wake(gen, ParkCancelled)
+		case <-stopc:
+		}
+	}()
+	return func() { close(stopc) }
+}
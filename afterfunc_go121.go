@@ -0,0 +1,15 @@
+//go:build go1.21
+// +build go1.21
+
+package gsysint
+
+import "context"
+
+// watchContext ends the park of generation gen with ParkCancelled once
+// ctx is done, unless stop is called first. No goroutine is started
+// before that for the contexts of package context, context.AfterFunc
+// starts one to wait on the Done of the others.
+func watchContext(p *Park, gen uint32, ctx context.Context) (stop func()) {
+	s := context.AfterFunc(ctx, func() { p.wake(gen, ParkCancelled) })
+	return func() { s() }
+}
@@ -0,0 +1,12 @@
+package gsysint
+
+// The atomics of the code that runs on the system stack: the gopark
+// unlockf and the timer callbacks. Under -race sync/atomic calls into the
+// race runtime, which needs the race context a g0 does not have. These
+// are not instrumented, the race detector does not see them.
+
+//go:noescape
+func casUint32(addr *uint32, old, new uint32) bool
+
+//go:noescape
+func loadUint32(addr *uint32) uint32
@@ -0,0 +1,18 @@
+#include "textflag.h"
+
+// func casUint32(addr *uint32, old, new uint32) bool
+TEXT ·casUint32(SB),NOSPLIT,$0-13
+	MOVL	addr+0(FP), BX
+	MOVL	old+4(FP), AX
+	MOVL	new+8(FP), CX
+	LOCK
+	CMPXCHGL	CX, 0(BX)
+	SETEQ	ret+12(FP)
+	RET
+
+// func loadUint32(addr *uint32) uint32
+TEXT ·loadUint32(SB),NOSPLIT,$0-8
+	MOVL	addr+0(FP), AX
+	MOVL	0(AX), AX
+	MOVL	AX, ret+4(FP)
+	RET
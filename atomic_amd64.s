@@ -0,0 +1,18 @@
+#include "textflag.h"
+
+// func casUint32(addr *uint32, old, new uint32) bool
+TEXT ·casUint32(SB),NOSPLIT,$0-17
+	MOVQ	addr+0(FP), BX
+	MOVL	old+8(FP), AX
+	MOVL	new+12(FP), CX
+	LOCK
+	CMPXCHGL	CX, 0(BX)
+	SETEQ	ret+16(FP)
+	RET
+
+// func loadUint32(addr *uint32) uint32
+TEXT ·loadUint32(SB),NOSPLIT,$0-12
+	MOVQ	addr+0(FP), AX
+	MOVL	0(AX), AX
+	MOVL	AX, ret+8(FP)
+	RET
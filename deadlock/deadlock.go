@@ -0,0 +1,449 @@
+// Package deadlock finds goroutines blocked on channels for good, the
+// partial deadlocks the runtime does not report as long as one goroutine
+// can still run.
+//
+// Detect builds a wait-for graph: a goroutine blocked on a channel waits
+// for the goroutines that reference the channel, as one of them may be
+// the other end. Goroutines that no live goroutine can reach are reported
+// in cycles, as orphans when their channels are referenced by no one
+// else at all, or as blocked behind either:
+//
+//	r, err := deadlock.Detect()
+//	if err == nil && !r.Empty() {
+//		log.Print(r)
+//	}
+//
+// The references are found, with the world stopped, by a conservative
+// scan of the stacks of all the other goroutines and of the globals,
+// following the pointers two levels into the heap. The heap objects are
+// bounded with the DWARF of the executable (see package layout), without
+// it the scan stays out of the heap. It is best-effort both ways: a
+// channel referenced only from farther in the heap makes its waiters look
+// deadlocked, and a stale word on a stack may hide them.
+// The stacks of the reported goroutines are taken after the graph, a
+// goroutine that has moved on meanwhile is dropped from the report.
+package deadlock
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/sitano/gsysint/dump"
+	"github.com/sitano/gsysint/g"
+)
+
+// ChanWait is a channel a goroutine is blocked on.
+type ChanWait struct {
+	Chan uintptr // address of the runtime channel
+	Send bool    // blocked on send, or on receive
+
+	// Holders are the ids of the other goroutines that reference the
+	// channel.
+	Holders []uint64
+}
+
+// Waiter is a deadlocked goroutine.
+type Waiter struct {
+	ID         uint64
+	WaitReason g.WaitReason
+	Chans      []ChanWait // none for a nil channel or an empty select
+	Stack      dump.Goroutine
+}
+
+// Report is the result of Detect.
+type Report struct {
+	// Cycles are the groups of goroutines that wait for one another.
+	Cycles [][]Waiter
+	// Orphans are the goroutines blocked on channels no other goroutine
+	// references, a nil channel or an empty select.
+	Orphans []Waiter
+	// Blocked are the goroutines waiting only for the ones above.
+	Blocked []Waiter
+}
+
+// Empty reports whether no deadlocked goroutine was found.
+func (r *Report) Empty() bool {
+	return len(r.Cycles) == 0 && len(r.Orphans) == 0 && len(r.Blocked) == 0
+}
+
+func (r *Report) String() string {
+	var b strings.Builder
+	for i, c := range r.Cycles {
+		fmt.Fprintf(&b, "cycle %d:\n", i+1)
+		for _, w := range c {
+			w.format(&b)
+		}
+	}
+	if len(r.Orphans) > 0 {
+		b.WriteString("orphans:\n")
+		for _, w := range r.Orphans {
+			w.format(&b)
+		}
+	}
+	if len(r.Blocked) > 0 {
+		b.WriteString("blocked:\n")
+		for _, w := range r.Blocked {
+			w.format(&b)
+		}
+	}
+	return b.String()
+}
+
+func (w *Waiter) format(b *strings.Builder) {
+	fmt.Fprintf(b, "\tgoroutine %d [%v]", w.ID, w.WaitReason)
+	for _, c := range w.Chans {
+		op := "recv"
+		if c.Send {
+			op = "send"
+		}
+		fmt.Fprintf(b, " %s %#x held by %v", op, c.Chan, c.Holders)
+	}
+	b.WriteString("\n")
+	for _, f := range w.Stack.Frames {
+		fmt.Fprintf(b, "\t\t%s\n\t\t\t%s:%d\n", f.Func, f.File, f.Line)
+	}
+}
+
+// ErrLayout is returned when the runtime layouts failed g.Verify.
+var ErrLayout = errors.New("deadlock: runtime layouts failed verification")
+
+// Detect looks for deadlocked goroutines. It stops the world while it
+// scans the goroutines, and again to take the stacks of the deadlocked
+// ones as runtime.Stack does, so run it on demand, not in a loop.
+func Detect() (*Report, error) {
+	if !g.Verified() {
+		return nil, ErrLayout
+	}
+	// The graph is built on a fresh goroutine: the caller is then parked
+	// and its stack scanned, and the detector data on the stack of the
+	// running goroutine references no channel.
+	self := uint64(g.CurG().GoID)
+	done := make(chan *Report, 1)
+	go func() {
+		done <- detect(self)
+	}()
+	r := <-done
+	if err := r.stacks(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+const (
+	// hops is how deep the scan follows the pointers into the heap.
+	hops = 2
+	// objectWords is how much of a heap object the scan reads.
+	objectWords = 256
+)
+
+const (
+	holderGlobal = ^uint64(0) // a global references the channel
+	holderTimer  = ^uint64(1) // the channel of a runtime timer
+)
+
+type waiter struct {
+	id     uint64
+	reason g.WaitReason
+	chans  []ChanWait
+	wakers map[uint64]bool
+}
+
+// detect builds the report of the goroutines other than the running one,
+// ignoring self, which waits for it.
+//
+// The goroutines are read with the world stopped: the stack of a running
+// goroutine can not be scanned, and a waiter may be woken at any point
+// and its sudogs reused. The DWARF the scanner bounds the heap objects
+// with is read before.
+func detect(self uint64) *Report {
+	me := g.CurG()
+	// The channels are kept complemented until the world is started
+	// again: the data of the detector must not look like the channels it
+	// looks for, a stale word pointing to it would hold them all.
+	chans := map[uintptr][]uint64{} // ^channel -> holders
+	s := newScanner(chans)
+
+	stw := stopTheWorld()
+	var gs []*g.G
+	g.ForEachG(func(gp *g.G) bool {
+		gs = append(gs, gp)
+		return true
+	})
+
+	ws := map[uint64]*waiter{}
+	s.bookkeeping(gs)
+	for _, gp := range gs {
+		id := uint64(gp.GoID)
+		if id == self || gp.Status().Base() != g.GWaiting {
+			continue
+		}
+		switch gp.WaitReason {
+		case g.WaitReasonChanReceiveNilChan, g.WaitReasonChanSendNilChan, g.WaitReasonSelectNoCases:
+			ws[id] = &waiter{id: id, reason: gp.WaitReason}
+		case g.WaitReasonChanReceive, g.WaitReasonChanSend, g.WaitReasonSelect:
+			if w := chanWaiter(gp, chans); w != nil {
+				ws[id] = w
+			}
+		}
+	}
+
+	s.roots(gs, me)
+	startTheWorld(stw)
+
+	for _, w := range ws {
+		w.wakers = map[uint64]bool{}
+		for i := range w.chans {
+			c := &w.chans[i]
+			hs := chans[c.Chan]
+			c.Chan = ^c.Chan
+			for _, h := range hs {
+				if h == w.id {
+					continue
+				}
+				w.wakers[h] = true
+				if h != holderGlobal && h != holderTimer {
+					c.Holders = append(c.Holders, h)
+				}
+			}
+			sort.Slice(c.Holders, func(i, j int) bool { return c.Holders[i] < c.Holders[j] })
+		}
+	}
+	return report(ws)
+}
+
+// chanWaiter reads the channels gp is blocked on from its sudogs, and
+// checks it in the wait queues under the channel locks. It returns nil
+// if gp is no longer in any: its waker was stopped between taking it out
+// and making it runnable.
+//
+// The world is stopped, gp and its sudogs stay as they are and the
+// channel locks are free. The channels of the waiter are complemented,
+// as the keys of chans.
+func chanWaiter(gp *g.G, chans map[uintptr][]uint64) *waiter {
+	id := uint64(gp.GoID)
+	w := &waiter{id: id, reason: gp.WaitReason}
+	for sg := gp.Waiting; sg != nil; sg = sg.WaitLink() {
+		c := sg.C()
+		if c == nil {
+			return nil
+		}
+		st := c.State()
+		if isTimer(c) {
+			chans[^uintptr(unsafe.Pointer(c))] = []uint64{holderTimer}
+		}
+		for _, send := range []bool{false, true} {
+			q := st.RecvQ
+			if send {
+				q = st.SendQ
+			}
+			for _, qid := range q {
+				if qid == id {
+					w.add(ChanWait{Chan: ^uintptr(unsafe.Pointer(c)), Send: send})
+					break
+				}
+			}
+		}
+	}
+	if len(w.chans) == 0 {
+		return nil
+	}
+	for _, c := range w.chans {
+		if _, ok := chans[c.Chan]; !ok {
+			chans[c.Chan] = nil
+		}
+	}
+	return w
+}
+
+// add adds c to the channels of w, a select may have several cases on
+// the same channel.
+func (w *waiter) add(c ChanWait) {
+	for _, wc := range w.chans {
+		if wc.Chan == c.Chan && wc.Send == c.Send {
+			return
+		}
+	}
+	w.chans = append(w.chans, c)
+}
+
+// report computes the deadlocked goroutines: starting from all the
+// waiters, the ones a live goroutine, a global or a timer may wake are
+// dropped until none is left to drop.
+func report(ws map[uint64]*waiter) *Report {
+	for changed := true; changed; {
+		changed = false
+		for id, w := range ws {
+			for h := range w.wakers {
+				if ws[h] == nil {
+					delete(ws, id)
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	ids := make([]uint64, 0, len(ws))
+	for id := range ws {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	r := &Report{}
+	inCycle := map[uint64]bool{}
+	for _, scc := range components(ids, ws) {
+		if len(scc) < 2 {
+			continue
+		}
+		var c []Waiter
+		for _, id := range scc {
+			inCycle[id] = true
+			c = append(c, ws[id].public())
+		}
+		r.Cycles = append(r.Cycles, c)
+	}
+	for _, id := range ids {
+		switch w := ws[id]; {
+		case inCycle[id]:
+		case len(w.wakers) == 0:
+			r.Orphans = append(r.Orphans, w.public())
+		default:
+			r.Blocked = append(r.Blocked, w.public())
+		}
+	}
+	return r
+}
+
+func (w *waiter) public() Waiter {
+	return Waiter{ID: w.id, WaitReason: w.reason, Chans: w.chans}
+}
+
+// components returns the strongly connected components of the wait-for
+// graph of ws, with Tarjan's algorithm, each sorted by id.
+func components(ids []uint64, ws map[uint64]*waiter) [][]uint64 {
+	index := map[uint64]int{}
+	low := map[uint64]int{}
+	on := map[uint64]bool{}
+	var stack []uint64
+	var sccs [][]uint64
+
+	var visit func(id uint64)
+	visit = func(id uint64) {
+		index[id], low[id] = len(index), len(index)
+		stack = append(stack, id)
+		on[id] = true
+		for h := range ws[id].wakers {
+			if _, ok := index[h]; !ok {
+				visit(h)
+				if low[h] < low[id] {
+					low[id] = low[h]
+				}
+			} else if on[h] && index[h] < low[id] {
+				low[id] = index[h]
+			}
+		}
+		if low[id] != index[id] {
+			return
+		}
+		var scc []uint64
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			on[top] = false
+			scc = append(scc, top)
+			if top == id {
+				break
+			}
+		}
+		sort.Slice(scc, func(i, j int) bool { return scc[i] < scc[j] })
+		sccs = append(sccs, scc)
+	}
+	for _, id := range ids {
+		if _, ok := index[id]; !ok {
+			visit(id)
+		}
+	}
+	return sccs
+}
+
+// stacks attaches the stacks to the waiters of r, and drops the ones that
+// are no longer blocked on a channel.
+func (r *Report) stacks() error {
+	if r.Empty() {
+		return nil
+	}
+	buf := make([]byte, 1<<16)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+	return r.attach(string(buf))
+}
+
+// attach attaches the stacks of the dump text to the waiters of r. The
+// goroutines of the dump are parsed one by one: one the parser fails on
+// is an error only if it is a waiter of r.
+func (r *Report) attach(text string) error {
+	ids := map[uint64]bool{}
+	for _, ws := range append([][]Waiter{r.Orphans, r.Blocked}, r.Cycles...) {
+		for _, w := range ws {
+			ids[w.ID] = true
+		}
+	}
+	byID := map[uint64]dump.Goroutine{}
+	for _, block := range strings.Split(strings.TrimRight(text, "\n"), "\n\n") {
+		gs, err := dump.ParseString(block + "\n")
+		if err != nil {
+			if id, ok := blockID(block); !ok || ids[id] {
+				return err
+			}
+			continue
+		}
+		for _, gr := range gs {
+			byID[gr.ID] = gr
+		}
+	}
+
+	keep := func(ws []Waiter) []Waiter {
+		out := ws[:0]
+		for _, w := range ws {
+			gr, ok := byID[w.ID]
+			if !ok || !strings.HasPrefix(gr.Status, "chan ") && !strings.HasPrefix(gr.Status, "select") {
+				continue
+			}
+			w.Stack = gr
+			out = append(out, w)
+		}
+		return out
+	}
+	cycles := r.Cycles[:0]
+	for _, c := range r.Cycles {
+		// A cycle with a goroutine gone is no longer one.
+		if n := len(c); len(keep(c)) == n {
+			cycles = append(cycles, c)
+		}
+	}
+	r.Cycles = cycles
+	r.Orphans = keep(r.Orphans)
+	r.Blocked = keep(r.Blocked)
+	return nil
+}
+
+// blockID returns the id in the header of a goroutine of a dump.
+func blockID(block string) (uint64, bool) {
+	f := strings.Fields(block)
+	if len(f) < 2 || f[0] != "goroutine" {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(f[1], 10, 64)
+	return id, err == nil
+}
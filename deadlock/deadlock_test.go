@@ -0,0 +1,251 @@
+package deadlock
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/sitano/gsysint/g"
+)
+
+// goroutines starts the scenario of TestDetect from a goroutine that
+// exits, so no stack but the ones of the scenario holds its channels. The
+// closures of the goroutines hold them hidden, a stale word pointing to a
+// closure holds none: a goroutine holds the channels it receives from
+// later in a local. release closes them and waits for the goroutines to
+// exit: the words left on their stacks would hold the channels of the
+// next run allocated at the same addresses.
+func goroutines(live chan int, ctx context.Context) (ids [6]uint64, release func()) {
+	type scenario struct {
+		ids   [6]uint64
+		chans [4]hidden
+	}
+	res := make(chan scenario)
+	creator := make(chan uint64, 1)
+	var wg sync.WaitGroup
+	go func() {
+		creator <- uint64(g.CurG().GoID)
+		gid := make(chan uint64)
+		id := func(f func()) uint64 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				gid <- uint64(g.CurG().GoID)
+				f()
+			}()
+			return <-gid
+		}
+		var chans [4]hidden
+		for i := range chans {
+			chans[i] = hide(make(chan int))
+		}
+		c1, c2, c3, orphan := chans[0], chans[1], chans[2], chans[3]
+		res <- scenario{
+			ids: [6]uint64{
+				id(func() {
+					c2, c3 := c2.reveal(), c3.reveal()
+					<-c1.reveal()
+					<-c2
+					<-c3
+				}),
+				id(func() {
+					c1 := c1.reveal()
+					<-c2.reveal()
+					<-c1
+				}),
+				id(func() { <-c3.reveal() }),
+				id(func() { <-orphan.reveal() }),
+				id(func() { <-live }),
+				id(func() { <-ctx.Done() }),
+			},
+			chans: chans,
+		}
+	}()
+	sc := <-res
+	// Its frame holds the channels until it is gone.
+	for id := <-creator; alive(id); {
+		runtime.Gosched()
+	}
+	return sc.ids, func() {
+		for _, h := range sc.chans {
+			close(h.reveal())
+		}
+		wg.Wait()
+	}
+}
+
+var forever struct {
+	nilChan, timer uint64
+}
+
+// The goroutines TestDetect can not release, on a nil channel and on a
+// timer, are started before any test to run on fresh stacks.
+func init() {
+	go func() {
+		atomic.StoreUint64(&forever.nilChan, uint64(g.CurG().GoID))
+		var c chan int
+		<-c
+	}()
+	go func() {
+		atomic.StoreUint64(&forever.timer, uint64(g.CurG().GoID))
+		select {
+		case <-make(chan int):
+		case <-time.After(time.Hour):
+		}
+	}()
+}
+
+// hidden is a channel the scan does not recognize, the goroutines blocked
+// on it keep it alive.
+type hidden uintptr
+
+func hide(c chan int) hidden { return hidden(^*(*uintptr)(unsafe.Pointer(&c))) }
+
+// reveal is not inlined: the compiler would complement the hidden word
+// where the channel is used, after the receives it is held across.
+//
+//go:noinline
+func (h hidden) reveal() chan int {
+	p := ^uintptr(h)
+	return *(*chan int)(unsafe.Pointer(&p))
+}
+
+// alive reports whether the goroutine id has not exited.
+func alive(id uint64) bool {
+	for _, gi := range g.AllGs() {
+		if gi.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// blocked waits until the goroutines are parked.
+func blocked(t *testing.T, ids ...uint64) {
+	for try := 0; ; try++ {
+		if try == 10000 {
+			t.Fatalf("goroutines %v are not parked", ids)
+		}
+		n := 0
+		for _, gi := range g.AllGs() {
+			for _, id := range ids {
+				if gi.ID == id && gi.Status == g.GWaiting {
+					n++
+				}
+			}
+		}
+		if n == len(ids) {
+			return
+		}
+		runtime.Gosched()
+	}
+}
+
+func TestDetect(t *testing.T) {
+	if !g.Verified() {
+		t.Skip(g.VerifyError())
+	}
+	live := make(chan int)
+	ctx, cancel := context.WithCancel(context.Background())
+	ids, release := goroutines(live, ctx)
+	a, b, behind, orphan, waiter, done := ids[0], ids[1], ids[2], ids[3], ids[4], ids[5]
+	nilChan, timer := atomic.LoadUint64(&forever.nilChan), atomic.LoadUint64(&forever.timer)
+	for nilChan == 0 || timer == 0 {
+		runtime.Gosched()
+		nilChan, timer = atomic.LoadUint64(&forever.nilChan), atomic.LoadUint64(&forever.timer)
+	}
+	blocked(t, append(ids[:], nilChan, timer)...)
+
+	r, err := Detect()
+	close(live)
+	cancel()
+	release()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in := func(ws []Waiter, id uint64) *Waiter {
+		for i := range ws {
+			if ws[i].ID == id {
+				return &ws[i]
+			}
+		}
+		return nil
+	}
+	var cycle []Waiter
+	for _, c := range r.Cycles {
+		if in(c, a) != nil {
+			cycle = c
+		}
+	}
+	if len(cycle) != 2 || in(cycle, b) == nil {
+		t.Fatalf("cycle of %d and %d not found in\n%v", a, b, r)
+	}
+	for _, w := range cycle {
+		if len(w.Chans) != 1 || w.Chans[0].Send || len(w.Stack.Frames) == 0 {
+			t.Fatalf("cycle goroutine %+v", w)
+		}
+	}
+	if w := in(r.Orphans, orphan); w == nil || len(w.Chans) != 1 {
+		t.Fatalf("orphan %d not found in\n%v", orphan, r)
+	}
+	if w := in(r.Orphans, nilChan); w == nil || w.WaitReason != g.WaitReasonChanReceiveNilChan {
+		t.Fatalf("nil channel receiver %d not found in\n%v", nilChan, r)
+	}
+	if w := in(r.Blocked, behind); w == nil || !holds(w.Chans[0].Holders, a) {
+		t.Fatalf("goroutine %d blocked behind %d not found in\n%v", behind, a, r)
+	}
+	awake := []uint64{timer, waiter}
+	if newScanner(nil).heap {
+		// The Done channel is held by the cancel func, which is held by
+		// the test: it is reached through the heap.
+		awake = append(awake, done)
+	}
+	for _, id := range awake {
+		if in(r.Orphans, id) != nil || in(r.Blocked, id) != nil || in(cycle, id) != nil {
+			t.Fatalf("live goroutine %d reported in\n%v", id, r)
+		}
+	}
+	if s := r.String(); !strings.Contains(s, "cycle 1:") || !strings.Contains(s, "deadlock.goroutines") {
+		t.Fatalf("report:\n%s", s)
+	}
+}
+
+func holds(holders []uint64, id uint64) bool {
+	for _, h := range holders {
+		if h == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestAttach(t *testing.T) {
+	const text = `goroutine 5 [chan receive]:
+main.f()
+	/src/app/main.go:5 +0x1d
+created by main.main in goroutine 1
+	/src/app/main.go:3 +0x20
+
+goroutine 7 [select]:
+main.g
+	/src/app/main.go:9 +0x1d
+`
+	r := &Report{Orphans: []Waiter{{ID: 5}}}
+	if err := r.attach(text); err != nil {
+		t.Fatalf("goroutine outside the report: %v", err)
+	}
+	if len(r.Orphans) != 1 || len(r.Orphans[0].Stack.Frames) != 1 {
+		t.Fatalf("orphans %+v", r.Orphans)
+	}
+
+	r = &Report{Blocked: []Waiter{{ID: 7}}}
+	if err := r.attach(text); err == nil {
+		t.Fatal("no error for a waiter the dump fails on")
+	}
+}
@@ -0,0 +1,203 @@
+package deadlock
+
+import (
+	"unsafe"
+
+	"github.com/sitano/gsysint/g"
+	"github.com/sitano/gsysint/layout"
+)
+
+const ptrSize = unsafe.Sizeof(uintptr(0))
+
+// The bounds of the pointer-holding globals, set by the linker.
+
+//go:linkname dataStart runtime.data
+var dataStart byte
+
+//go:linkname dataEnd runtime.edata
+var dataEnd byte
+
+//go:linkname bssStart runtime.bss
+var bssStart byte
+
+//go:linkname bssEnd runtime.ebss
+var bssEnd byte
+
+//go:linkname allm runtime.allm
+var allm *g.M
+
+//go:linkname allp runtime.allp
+var allp []unsafe.Pointer
+
+// spanOfHeap returns the in-use heap span of p, nil if p is not a heap
+// pointer. It does not trust p.
+//
+//go:linkname spanOfHeap runtime.spanOfHeap
+func spanOfHeap(p uintptr) unsafe.Pointer
+
+// isSystemGoroutine reports whether gp is a goroutine of the runtime, the
+// finalizer goroutine included unless it runs a finalizer.
+//
+//go:linkname isSystemGoroutine runtime.isSystemGoroutine
+func isSystemGoroutine(gp *g.G, fixed bool) bool
+
+// scanner looks for the references to the channels of chans in the
+// memory of the roots, and records their holders.
+type scanner struct {
+	chans  map[uintptr][]uint64 // ^channel -> holders
+	holder uint64               // the root being scanned
+	seen   map[uintptr]bool     // heap objects scanned for the root
+	skip   map[uintptr]bool     // heap objects never scanned
+
+	// The offsets of mspan.startAddr and mspan.elemsize, to find the
+	// bounds of the heap objects. The scan does not enter the heap
+	// without the DWARF of the executable.
+	heap                bool
+	startAddr, elemSize uintptr
+
+	// The bounds of p.timers, the timers are not scanned without them.
+	timers, timersSize uintptr
+}
+
+func newScanner(chans map[uintptr][]uint64) *scanner {
+	s := &scanner{chans: chans, skip: map[uintptr]bool{}}
+	if l, err := layout.Runtime("mspan"); err == nil {
+		start, err1 := l.Offset("startAddr")
+		size, err2 := l.Offset("elemsize")
+		s.heap, s.startAddr, s.elemSize = err1 == nil && err2 == nil, start, size
+	}
+	if l, err := layout.P(); err == nil {
+		if f, err := l.Field("timers"); err == nil {
+			s.timers, s.timersSize = f.Offset, f.Size
+		}
+	}
+	return s
+}
+
+// roots scans the globals and the stacks of the goroutines of gs but for
+// me, the running detector. The world is stopped: the other goroutines
+// are parked, runnable or in a system call.
+//
+// The goroutines of the runtime hold no channel of the program, their
+// stacks are left out: the GC leaves the heap pointers it marked on them.
+func (s *scanner) roots(gs []*g.G, me *g.G) {
+	s.root(holderGlobal)
+	s.scan(uintptr(unsafe.Pointer(&dataStart)), uintptr(unsafe.Pointer(&dataEnd)), hops)
+	s.scan(uintptr(unsafe.Pointer(&bssStart)), uintptr(unsafe.Pointer(&bssEnd)), hops)
+	for _, pp := range allp {
+		s.scanP(pp)
+	}
+
+	for _, gp := range gs {
+		if gp == me || isSystemGoroutine(gp, false) {
+			continue
+		}
+		var lo uintptr
+		switch gp.Status().Base() {
+		case g.GRunnable, g.GWaiting, g.GPreempted:
+			// The stack of a goroutine that is not running is stable
+			// from the saved sp up, until it runs again.
+			lo = gp.Sched.SP()
+		case g.GSyscall:
+			// So is the stack of a goroutine in a system or a cgo call,
+			// from the sp saved on the way in.
+			lo = gp.SysCallSP
+		}
+		s.root(uint64(gp.GoID))
+		hi := gp.Stack.Hi()
+		if lo < gp.Stack.Lo() || lo >= hi {
+			// A goroutine that can not be scanned is live, it may
+			// hold any channel.
+			s.holdAll()
+			continue
+		}
+		s.scan(lo, hi, hops)
+	}
+}
+
+// scanP scans the timers of the P pp, which hold the channels of
+// time.After and time.Tick. The rest of the P is the scheduler's: its
+// page cache and write barrier buffer hold the addresses of dead objects.
+func (s *scanner) scanP(pp unsafe.Pointer) {
+	if s.timersSize != 0 {
+		lo := uintptr(pp) + s.timers
+		s.scan(lo, lo+s.timersSize, hops)
+	}
+}
+
+// holdAll records the root as a holder of every channel.
+func (s *scanner) holdAll() {
+	for c, hs := range s.chans {
+		s.chans[c] = append(hs, s.holder)
+	}
+}
+
+// bookkeeping keeps the scan out of the Gs of gs and their sudogs, and out
+// of the Ms: they are the records of the scheduler, a goroutine that
+// reaches the G of a waiter does not hold the channels it waits on. The Ps
+// are scanned once, with the globals. The world is stopped, allm and allp
+// stay as they are.
+func (s *scanner) bookkeeping(gs []*g.G) {
+	for _, gp := range gs {
+		s.skip[uintptr(unsafe.Pointer(gp))] = true
+		for sg := gp.Waiting; sg != nil; sg = sg.WaitLink() {
+			s.skip[uintptr(unsafe.Pointer(sg))] = true
+		}
+	}
+	for mp := allm; mp != nil; mp = mp.AllLink {
+		s.skip[uintptr(unsafe.Pointer(mp))] = true
+	}
+	for _, pp := range allp {
+		s.skip[uintptr(pp)] = true
+	}
+}
+
+func (s *scanner) root(holder uint64) {
+	s.holder = holder
+	s.seen = map[uintptr]bool{}
+}
+
+// scan reads the words of [lo, hi) and of the heap objects they point to,
+// depth levels deep, up to objectWords of each.
+func (s *scanner) scan(lo, hi uintptr, depth int) {
+	for p := lo &^ (ptrSize - 1); p+ptrSize <= hi; p += ptrSize {
+		w := load(p)
+		if hs, ok := s.chans[^w]; ok && (len(hs) == 0 || hs[len(hs)-1] != s.holder) {
+			s.chans[^w] = append(hs, s.holder)
+		}
+		if depth == 0 || !s.heap || w == 0 {
+			continue
+		}
+		if base, size := s.object(w); size != 0 && !s.seen[base] && !s.skip[base] {
+			s.seen[base] = true
+			if size > objectWords*ptrSize {
+				size = objectWords * ptrSize
+			}
+			s.scan(base, base+size, depth-1)
+		}
+	}
+}
+
+// object returns the bounds of the heap object p points into, a zero
+// size if p is not a heap pointer. The span is read racily, it may be
+// swept meanwhile but its memory stays mapped.
+func (s *scanner) object(p uintptr) (base, size uintptr) {
+	span := spanOfHeap(p)
+	if span == nil {
+		return 0, 0
+	}
+	start := load(uintptr(span) + s.startAddr)
+	size = load(uintptr(span) + s.elemSize)
+	if size == 0 || p < start {
+		return 0, 0
+	}
+	return start + (p-start)/size*size, size
+}
+
+// load reads the word at p, the racy read of the scan, which the race
+// detector must not see.
+//
+//go:norace
+func load(p uintptr) uintptr {
+	return **(**uintptr)(unsafe.Pointer(&p))
+}
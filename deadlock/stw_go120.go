@@ -0,0 +1,27 @@
+//go:build !go1.21
+// +build !go1.21
+
+package deadlock
+
+import (
+	_ "unsafe"
+)
+
+//go:linkname runtimeStopTheWorld runtime.stopTheWorld
+func runtimeStopTheWorld(reason string)
+
+//go:linkname runtimeStartTheWorld runtime.startTheWorld
+func runtimeStartTheWorld()
+
+type worldStop struct{}
+
+// stopTheWorld stops every goroutine but the calling one, as
+// runtime.Stack does to dump them all.
+func stopTheWorld() worldStop {
+	runtimeStopTheWorld("stack trace")
+	return worldStop{}
+}
+
+func startTheWorld(worldStop) {
+	runtimeStartTheWorld()
+}
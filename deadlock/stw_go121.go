@@ -0,0 +1,30 @@
+//go:build go1.21 && !go1.22
+// +build go1.21,!go1.22
+
+package deadlock
+
+import (
+	_ "unsafe"
+)
+
+// stwAllGoroutinesStack is the runtime.stwReason of runtime.Stack.
+const stwAllGoroutinesStack = 6
+
+//go:linkname runtimeStopTheWorld runtime.stopTheWorld
+func runtimeStopTheWorld(reason uint8)
+
+//go:linkname runtimeStartTheWorld runtime.startTheWorld
+func runtimeStartTheWorld()
+
+type worldStop struct{}
+
+// stopTheWorld stops every goroutine but the calling one, as
+// runtime.Stack does to dump them all.
+func stopTheWorld() worldStop {
+	runtimeStopTheWorld(stwAllGoroutinesStack)
+	return worldStop{}
+}
+
+func startTheWorld(worldStop) {
+	runtimeStartTheWorld()
+}
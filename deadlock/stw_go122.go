@@ -0,0 +1,31 @@
+//go:build go1.22 && !go1.23
+// +build go1.22,!go1.23
+
+package deadlock
+
+import (
+	_ "unsafe"
+)
+
+// stwAllGoroutinesStack is the runtime.stwReason of runtime.Stack.
+const stwAllGoroutinesStack = 6
+
+// worldStop mirrors runtime.worldStop.
+type worldStop struct {
+	reason uint8
+	start  int64
+}
+
+//go:linkname runtimeStopTheWorld runtime.stopTheWorld
+func runtimeStopTheWorld(reason uint8) worldStop
+
+// startTheWorld restarts the world stopped by w.
+//
+//go:linkname startTheWorld runtime.startTheWorld
+func startTheWorld(w worldStop)
+
+// stopTheWorld stops every goroutine but the calling one, as
+// runtime.Stack does to dump them all.
+func stopTheWorld() worldStop {
+	return runtimeStopTheWorld(stwAllGoroutinesStack)
+}
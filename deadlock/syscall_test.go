@@ -0,0 +1,79 @@
+//go:build linux || darwin || freebsd
+// +build linux darwin freebsd
+
+package deadlock
+
+import (
+	"runtime"
+	"sync"
+	"syscall"
+	"testing"
+
+	"github.com/sitano/gsysint/g"
+)
+
+// produce sends on c once the read of fd returns, c stays on its stack
+// during the system call.
+func produce(fd int, c chan int) {
+	var b [1]byte
+	syscall.Read(fd, b[:])
+	c <- 1
+}
+
+func TestDetectSyscall(t *testing.T) {
+	if !g.Verified() {
+		t.Skip(g.VerifyError())
+	}
+	var fds [2]int
+	if err := syscall.Pipe(fds[:]); err != nil {
+		t.Fatal(err)
+	}
+	defer syscall.Close(fds[0])
+	defer syscall.Close(fds[1])
+
+	// The goroutines are started from one that exits, so only their
+	// stacks hold the channel.
+	var wg sync.WaitGroup
+	ids := make(chan [2]uint64)
+	go func() {
+		c := make(chan int)
+		gid := make(chan uint64)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			gid <- uint64(g.CurG().GoID)
+			produce(fds[0], c)
+		}()
+		producer := <-gid
+		go func() {
+			defer wg.Done()
+			gid <- uint64(g.CurG().GoID)
+			<-c
+		}()
+		ids <- [2]uint64{producer, <-gid}
+	}()
+	id := <-ids
+	producer, consumer := id[0], id[1]
+	for syscalled := false; !syscalled; runtime.Gosched() {
+		for _, gi := range g.AllGs() {
+			if gi.ID == producer && gi.Status == g.GSyscall {
+				syscalled = true
+			}
+		}
+	}
+	blocked(t, consumer)
+
+	r, err := Detect()
+	syscall.Write(fds[1], []byte{0})
+	wg.Wait()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ws := range append([][]Waiter{r.Orphans, r.Blocked}, r.Cycles...) {
+		for _, w := range ws {
+			if w.ID == consumer {
+				t.Fatalf("consumer of a goroutine in a system call reported in\n%v", r)
+			}
+		}
+	}
+}
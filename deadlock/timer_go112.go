@@ -0,0 +1,24 @@
+//go:build !go1.23
+// +build !go1.23
+
+package deadlock
+
+import (
+	"time"
+	"unsafe"
+
+	"github.com/sitano/gsysint/g"
+)
+
+// timeType is the element type of the time.Timer and time.Ticker channels.
+var timeType = func() *g.Type {
+	var i interface{} = time.Time{}
+	return (*g.Type)((*[2]unsafe.Pointer)(unsafe.Pointer(&i))[0])
+}()
+
+// isTimer reports whether c is fed by a runtime timer, which holds it out
+// of the reach of the scan. Before go1.23 the channel does not know its
+// timer, any channel of time.Time with a buffer of 1 is taken for one.
+func isTimer(c *g.HChan) bool {
+	return c.ElemType() == timeType && c.DataQSiz() == 1
+}
@@ -0,0 +1,14 @@
+//go:build go1.23
+// +build go1.23
+
+package deadlock
+
+import (
+	"github.com/sitano/gsysint/g"
+)
+
+// isTimer reports whether c is fed by a runtime timer, which holds it out
+// of the reach of the scan.
+func isTimer(c *g.HChan) bool {
+	return c.Timer() != nil
+}
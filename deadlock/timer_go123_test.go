@@ -0,0 +1,65 @@
+//go:build go1.23
+// +build go1.23
+
+package deadlock
+
+import (
+	"sync"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/sitano/gsysint/g"
+)
+
+func TestDetectTimeChan(t *testing.T) {
+	if !g.Verified() {
+		t.Skip(g.VerifyError())
+	}
+	// A stale word on a stack may hold the channel, see TestDetect.
+	for try := 0; try < 5; try++ {
+		if orphanTimeChan(t) {
+			return
+		}
+	}
+	t.Fatal("receiver from a channel of time.Time is not reported")
+}
+
+// orphanTimeChan reports whether a goroutine blocked on a channel of
+// time.Time, that no timer feeds, is reported as an orphan.
+func orphanTimeChan(t *testing.T) bool {
+	type started struct {
+		id uint64
+		c  uintptr // the channel, hidden from the scan
+	}
+	res := make(chan started)
+	var wg sync.WaitGroup
+	go func() {
+		c, gid := make(chan time.Time, 1), make(chan uint64)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			gid <- uint64(g.CurG().GoID)
+			<-c
+		}()
+		res <- started{id: <-gid, c: ^*(*uintptr)(unsafe.Pointer(&c))}
+	}()
+	s := <-res
+	defer func() {
+		p := ^s.c
+		close(*(*chan time.Time)(unsafe.Pointer(&p)))
+		wg.Wait()
+	}()
+	blocked(t, s.id)
+
+	r, err := Detect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, w := range r.Orphans {
+		if w.ID == s.id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,374 @@
+// Package dump parses goroutine dumps: the text of runtime.Stack(buf,
+// true), of the goroutine profile with debug=2 and of crash tracebacks,
+// GOTRACEBACK=system and tracebackancestors included.
+//
+// A dump is a sequence of goroutine blocks separated by blank lines:
+//
+//	goroutine 18 [chan receive, 2 minutes, locked to thread]:
+//	main.worker(0xc000010000, 0x3)
+//		/src/main.go:20 +0x3d
+//	created by main.main in goroutine 1
+//		/src/main.go:15 +0x45
+//
+// Text outside of the blocks, such as the panic message and the signal
+// or register dumps of a crash, is skipped.
+package dump
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Goroutine is a goroutine block of a dump.
+type Goroutine struct {
+	ID          uint64
+	Status      string // "running", "chan receive", "IO wait", ...
+	WaitMinutes int    // how long it has been blocked, if a minute or more
+	Locked      bool   // locked to thread
+	Frames      []Frame
+	Elided      bool // frames were elided from the middle of the stack
+
+	// CreatedBy is the go statement that started the goroutine, nil
+	// for the main goroutine and the runtime ones.
+	CreatedBy *Frame
+	// ParentID is the goroutine that executed the go statement, go1.21+.
+	ParentID uint64
+
+	// Ancestors are the goroutines the creation chain comes from, with
+	// GODEBUG=tracebackancestors=N.
+	Ancestors []Ancestor
+}
+
+// Ancestor is a goroutine of the creation chain, at the time it created
+// the next one.
+type Ancestor struct {
+	ID        uint64
+	Frames    []Frame
+	Elided    bool
+	CreatedBy *Frame
+	ParentID  uint64
+}
+
+// Frame is a function call of a stack.
+type Frame struct {
+	Func   string // package path qualified function name
+	Args   string // argument words as printed, "..." for inlined calls
+	File   string
+	Line   int
+	Offset uint64 // pc offset from the function entry, 0 if not printed
+}
+
+// ErrTruncated is returned for a dump that ends in the middle of a
+// goroutine block, as runtime.Stack does when the buffer is too small.
+var ErrTruncated = errors.New("dump: truncated goroutine dump")
+
+// SyntaxError reports a malformed line of a dump.
+type SyntaxError struct {
+	Line int    // 1-based line number
+	Text string // the line
+	Msg  string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("dump: line %d: %s: %q", e.Line, e.Msg, e.Text)
+}
+
+const (
+	headerPrefix     = "goroutine "
+	createdByPrefix  = "created by "
+	originatingFrom  = "[originating from goroutine "
+	elided           = "...additional frames elided..."
+	elidedFrames     = " frames elided..."
+	stackUnavailable = "goroutine running on other thread; stack unavailable"
+)
+
+// Parse reads a dump and returns its goroutines in order. On error it
+// also returns the goroutines parsed before the faulty one.
+func Parse(r io.Reader) ([]Goroutine, error) {
+	var p parser
+	lr := &lastByteReader{r: r}
+	s := bufio.NewScanner(lr)
+	s.Buffer(make([]byte, 64*1024), 1<<20)
+	for s.Scan() {
+		p.lines = append(p.lines, strings.TrimRight(s.Text(), "\r"))
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	p.partial = lr.n > 0 && lr.last != '\n'
+	return p.parse()
+}
+
+// lastByteReader remembers the last byte read, to tell whether the input
+// ends in the middle of a line.
+type lastByteReader struct {
+	r    io.Reader
+	n    int64
+	last byte
+}
+
+func (r *lastByteReader) Read(b []byte) (int, error) {
+	n, err := r.r.Read(b)
+	if n > 0 {
+		r.n += int64(n)
+		r.last = b[n-1]
+	}
+	return n, err
+}
+
+// ParseString parses a dump held in a string.
+func ParseString(s string) ([]Goroutine, error) {
+	return Parse(strings.NewReader(s))
+}
+
+type parser struct {
+	lines   []string
+	n       int // lines consumed
+	text    string
+	partial bool // the last line is cut, the input does not end with a newline
+}
+
+func (p *parser) next() bool {
+	if p.n == len(p.lines) {
+		return false
+	}
+	p.text = p.lines[p.n]
+	p.n++
+	return true
+}
+
+func (p *parser) unread() { p.n-- }
+
+// cut reports whether the current line is the last one, cut in its middle.
+func (p *parser) cut() bool {
+	return p.n == len(p.lines) && p.partial
+}
+
+// errorf returns a SyntaxError for the current line, or ErrTruncated
+// if it is the last one and is cut: runtime.Stack cuts the dump anywhere.
+func (p *parser) errorf(format string, args ...interface{}) error {
+	if p.cut() {
+		return ErrTruncated
+	}
+	return &SyntaxError{Line: p.n, Text: p.text, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) parse() ([]Goroutine, error) {
+	var gs []Goroutine
+	for p.next() {
+		if !strings.HasPrefix(p.text, headerPrefix) || p.text == stackUnavailable {
+			continue
+		}
+		if !strings.HasSuffix(p.text, ":") {
+			if p.cut() {
+				return gs, ErrTruncated
+			}
+			continue
+		}
+		g, err := p.goroutine()
+		if err != nil {
+			return gs, err
+		}
+		gs = append(gs, g)
+	}
+	return gs, nil
+}
+
+// goroutine parses a block, p.text is its header.
+func (p *parser) goroutine() (Goroutine, error) {
+	var g Goroutine
+	if err := p.header(&g); err != nil {
+		return g, err
+	}
+	var err error
+	g.Frames, g.Elided, g.CreatedBy, g.ParentID, err = p.stack()
+	if err != nil {
+		return g, err
+	}
+	for p.next() {
+		if !strings.HasPrefix(p.text, originatingFrom) {
+			p.unread()
+			break
+		}
+		a := Ancestor{}
+		id := strings.TrimSuffix(strings.TrimPrefix(p.text, originatingFrom), "]:")
+		if a.ID, err = strconv.ParseUint(id, 10, 64); err != nil {
+			return g, p.errorf("bad ancestor goroutine id")
+		}
+		a.Frames, a.Elided, a.CreatedBy, a.ParentID, err = p.stack()
+		if err != nil {
+			return g, err
+		}
+		g.Ancestors = append(g.Ancestors, a)
+	}
+	return g, nil
+}
+
+// header parses "goroutine 18 [chan receive, 2 minutes, locked to thread]:",
+// with the gp= m= words of GOTRACEBACK=system between the id and the status.
+func (p *parser) header(g *Goroutine) error {
+	s := strings.TrimSuffix(strings.TrimPrefix(p.text, headerPrefix), ":")
+	i := strings.IndexByte(s, ' ')
+	if i < 0 {
+		return p.errorf("goroutine header without status")
+	}
+	id, err := strconv.ParseUint(s[:i], 10, 64)
+	if err != nil {
+		return p.errorf("bad goroutine id")
+	}
+	g.ID = id
+
+	open, end := strings.IndexByte(s, '['), len(s)-1
+	if open < 0 || s[end] != ']' {
+		return p.errorf("goroutine header without [status]")
+	}
+	for i, item := range strings.Split(s[open+1:end], ", ") {
+		switch {
+		case i == 0:
+			g.Status = item
+		case item == "locked to thread":
+			g.Locked = true
+		case strings.HasSuffix(item, " minutes"):
+			n, err := strconv.Atoi(strings.TrimSuffix(item, " minutes"))
+			if err != nil {
+				return p.errorf("bad wait minutes")
+			}
+			g.WaitMinutes = n
+		}
+	}
+	if g.Status == "" {
+		return p.errorf("goroutine header with empty status")
+	}
+	return nil
+}
+
+// stack parses frames up to the end of the stack, which is a blank line,
+// the end of the input, an ancestor block or the line after created by.
+func (p *parser) stack() (frames []Frame, elide bool, createdBy *Frame, parent uint64, err error) {
+	unavailable := false
+	for p.next() {
+		switch {
+		case p.text == "":
+			if len(frames) == 0 && createdBy == nil && !unavailable {
+				return nil, false, nil, 0, p.errorf("goroutine without frames")
+			}
+			return frames, elide, createdBy, parent, nil
+		case strings.HasPrefix(p.text, originatingFrom), createdBy != nil:
+			// created by is the last entry of a stack, what follows is
+			// the next ancestor or text after the dump ("exit status 2").
+			p.unread()
+			return frames, elide, createdBy, parent, nil
+		case isElided(p.text):
+			elide = true
+		case strings.TrimSpace(p.text) == stackUnavailable:
+			unavailable = true
+		case strings.HasPrefix(p.text, createdByPrefix):
+			f := Frame{Func: strings.TrimPrefix(p.text, createdByPrefix)}
+			if i := strings.Index(f.Func, " in goroutine "); i >= 0 {
+				if parent, err = strconv.ParseUint(f.Func[i+len(" in goroutine "):], 10, 64); err != nil {
+					return nil, false, nil, 0, p.errorf("bad parent goroutine id")
+				}
+				f.Func = f.Func[:i]
+			}
+			if err := p.location(&f); err != nil {
+				return nil, false, nil, 0, err
+			}
+			createdBy = &f
+		case strings.HasPrefix(p.text, "\t"):
+			return nil, false, nil, 0, p.errorf("file position without function")
+		default:
+			var f Frame
+			if err := p.call(&f); err != nil {
+				return nil, false, nil, 0, err
+			}
+			if err := p.location(&f); err != nil {
+				return nil, false, nil, 0, err
+			}
+			frames = append(frames, f)
+		}
+	}
+	// The last block of a complete dump may end without a blank line.
+	if len(frames) == 0 && createdBy == nil && !unavailable {
+		return nil, false, nil, 0, ErrTruncated
+	}
+	return frames, elide, createdBy, parent, nil
+}
+
+// isElided reports whether s is the line of the frames elided from the
+// middle of a deep stack: "...additional frames elided..." up to go1.20,
+// "...101 frames elided..." since.
+func isElided(s string) bool {
+	if s == elided {
+		return true
+	}
+	if !strings.HasPrefix(s, "...") || !strings.HasSuffix(s, elidedFrames) {
+		return false
+	}
+	_, err := strconv.ParseUint(s[len("..."):len(s)-len(elidedFrames)], 10, 64)
+	return err == nil
+}
+
+// call parses "main.(*T).wait(0xc000010000, {0x1, 0x2}, ...)".
+func (p *parser) call(f *Frame) error {
+	s := p.text
+	if !strings.HasSuffix(s, ")") {
+		return p.errorf("function call without argument list")
+	}
+	depth := 0
+	for i := len(s) - 1; i >= 0; i-- {
+		switch s[i] {
+		case ')':
+			depth++
+		case '(':
+			depth--
+			if depth == 0 {
+				if i == 0 {
+					return p.errorf("function call without function")
+				}
+				f.Func, f.Args = s[:i], s[i+1:len(s)-1]
+				return nil
+			}
+		}
+	}
+	return p.errorf("unbalanced argument list")
+}
+
+// location parses the "\t/src/main.go:20 +0x3d fp=0x... sp=0x... pc=0x..."
+// line that follows a call. The file path may hold spaces, the pc offset
+// and the frame words are cut from the end.
+func (p *parser) location(f *Frame) error {
+	if !p.next() {
+		return ErrTruncated
+	}
+	if !strings.HasPrefix(p.text, "\t") {
+		return p.errorf("function without file position")
+	}
+	pos := p.text[1:]
+	if i := strings.LastIndex(pos, " fp="); i >= 0 {
+		pos = pos[:i]
+	}
+	var err error
+	if i := strings.LastIndex(pos, " +0x"); i >= 0 {
+		if f.Offset, err = strconv.ParseUint(pos[i+len(" +0x"):], 16, 64); err != nil {
+			return p.errorf("bad pc offset")
+		}
+		pos = pos[:i]
+	}
+	if strings.TrimSpace(pos) == "" {
+		return p.errorf("empty file position")
+	}
+	colon := strings.LastIndexByte(pos, ':')
+	if colon < 0 {
+		return p.errorf("file position without line")
+	}
+	line, err := strconv.Atoi(pos[colon+1:])
+	if err != nil {
+		return p.errorf("bad line number")
+	}
+	f.File, f.Line = pos[:colon], line
+	return nil
+}
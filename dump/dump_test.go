@@ -0,0 +1,165 @@
+package dump
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestGolden(t *testing.T) {
+	inputs, err := filepath.Glob(filepath.Join("testdata", "*.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, in := range inputs {
+		name := strings.TrimSuffix(filepath.Base(in), ".txt")
+		t.Run(name, func(t *testing.T) {
+			f, err := os.Open(in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+			gs, err := Parse(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := json.MarshalIndent(gs, "", "\t")
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, '\n')
+
+			golden := filepath.Join("testdata", name+".golden")
+			if *update {
+				if err := ioutil.WriteFile(golden, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s does not match %s, run go test -update:\n%s", in, golden, got)
+			}
+		})
+	}
+}
+
+func TestCurrent(t *testing.T) {
+	buf := make([]byte, 1<<20)
+	gs, err := Parse(bytes.NewReader(buf[:runtime.Stack(buf, true)]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gs) == 0 || gs[0].Status != "running" {
+		t.Fatalf("first goroutine is not the running one: %+v", gs)
+	}
+	var found bool
+	for _, f := range gs[0].Frames {
+		if f.Func == "github.com/sitano/gsysint/dump.TestCurrent" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("TestCurrent is not on the running stack: %+v", gs[0].Frames)
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "stack.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	all, err := ParseString(string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// runtime.Stack cuts the dump at any byte when the buffer is short.
+	var truncated int
+	for n := 0; n < len(data); n++ {
+		gs, err := ParseString(string(data[:n]))
+		switch err {
+		case nil:
+		case ErrTruncated:
+			truncated++
+		default:
+			t.Fatalf("cut at %d: %v", n, err)
+		}
+		if len(gs) > len(all) {
+			t.Fatalf("cut at %d: %d goroutines, the whole dump has %d", n, len(gs), len(all))
+		}
+		for i, g := range gs {
+			if g.ID != all[i].ID || len(g.Frames) == 0 {
+				t.Fatalf("cut at %d: goroutine %d is %+v, want %+v", n, i, g, all[i])
+			}
+		}
+	}
+	if truncated == 0 {
+		t.Fatal("no cut reported ErrTruncated")
+	}
+
+	if _, err := ParseString("goroutine 1 [running]:\nmain.main()\n\t/src/ma"); err != ErrTruncated {
+		t.Fatalf("got %v, want ErrTruncated", err)
+	}
+}
+
+func TestPathWithSpaces(t *testing.T) {
+	gs, err := ParseString("goroutine 1 [running]:\n" +
+		"main.f(...)\n\t/home/u/my proj/f.go:9\n" +
+		"main.main()\n\t/home/u/my proj/main.go:5 +0x1d\n" +
+		"created by main.init in goroutine 2\n\t/home/u/my proj/x y.go:7 +0x2 fp=0x1 sp=0x2 pc=0x3\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Frame{
+		{Func: "main.f", Args: "...", File: "/home/u/my proj/f.go", Line: 9},
+		{Func: "main.main", File: "/home/u/my proj/main.go", Line: 5, Offset: 0x1d},
+	}
+	if len(gs) != 1 || len(gs[0].Frames) != 2 || gs[0].Frames[0] != want[0] || gs[0].Frames[1] != want[1] {
+		t.Fatalf("got %+v, want frames %+v", gs, want)
+	}
+	if c := gs[0].CreatedBy; c == nil || c.File != "/home/u/my proj/x y.go" || c.Line != 7 || c.Offset != 2 {
+		t.Fatalf("created by %+v", c)
+	}
+}
+
+func TestSyntaxError(t *testing.T) {
+	for _, c := range []struct {
+		name, in string
+		line     int
+	}{
+		{"bad id", "goroutine x [running]:\nmain.main()\n\t/src/main.go:1 +0x1\n\n", 1},
+		{"no status", "goroutine 1 running:\nmain.main()\n\t/src/main.go:1 +0x1\n\n", 1},
+		{"bad minutes", "goroutine 1 [sleep, x minutes]:\nmain.main()\n\t/src/main.go:1 +0x1\n\n", 1},
+		{"no frames", "goroutine 1 [running]:\n\ngoroutine 2 [running]:\n", 2},
+		{"no position", "goroutine 1 [running]:\nmain.main()\nmain.f()\n\t/src/main.go:1\n\n", 3},
+		{"no function", "goroutine 1 [running]:\n\t/src/main.go:1 +0x1\n\n", 2},
+		{"no args", "goroutine 1 [running]:\nmain.main\n\t/src/main.go:1 +0x1\n\n", 2},
+		{"unbalanced args", "goroutine 1 [running]:\nmain.main())\n\t/src/main.go:1 +0x1\n\n", 2},
+		{"bad line", "goroutine 1 [running]:\nmain.main()\n\t/src/main.go:x +0x1\n\n", 3},
+		{"bad parent", "goroutine 1 [running]:\nmain.f()\n\t/src/main.go:1\ncreated by main.main in goroutine x\n\t/src/main.go:2 +0x1\n\n", 4},
+		{"bad last line", "goroutine 1 [running]:\nmain.main()\n\t/src/main.go:x +0x1\n", 3},
+		{"bad offset", "goroutine 1 [running]:\nmain.main()\n\t/src/main.go:1 +0xz\n", 3},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := ParseString(c.in)
+			se, ok := err.(*SyntaxError)
+			if !ok {
+				t.Fatalf("got %v, want a SyntaxError", err)
+			}
+			if se.Line != c.line {
+				t.Fatalf("error at line %d, want %d: %v", se.Line, c.line, se)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package gsysint
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/sitano/gsysint/g"
+)
+
+// Event is a one-shot notification on a runtime note, for exactly one
+// sleeper and exactly one waker. The sleeper blocks its thread, not only
+// the goroutine: the P is handed off as in a blocking syscall, so the
+// wakeup does not wait for the scheduler.
+//
+// A second Signal is a no-op rather than the runtime throw of a double
+// notewakeup. Built with the gsysintdebug tag, Event also panics on a
+// second Signal, a second sleeper and a Reset under a sleeper.
+//
+// A zero Event is ready to use.
+type Event struct {
+	note     g.Note
+	signaled uint32
+	sleepers int32 // gsysintdebug only
+}
+
+// Signal wakes the sleeper, or the next Wait returns at once.
+func (e *Event) Signal() {
+	if !atomic.CompareAndSwapUint32(&e.signaled, 0, 1) {
+		if eventDebug {
+			panic("gsysint: Event signaled twice")
+		}
+		return
+	}
+	g.NoteWakeup(&e.note)
+}
+
+// Signaled reports whether e has been signaled since the last Reset.
+func (e *Event) Signaled() bool {
+	return atomic.LoadUint32(&e.signaled) != 0
+}
+
+// Wait blocks until e is signaled.
+func (e *Event) Wait() {
+	e.sleep(-1)
+}
+
+// WaitTimeout blocks until e is signaled or d elapses, and reports
+// whether e was signaled.
+func (e *Event) WaitTimeout(d time.Duration) bool {
+	if d <= 0 {
+		return e.Signaled()
+	}
+	return e.sleep(int64(d))
+}
+
+// Reset makes e ready for another round. The sleeper and the waker of the
+// previous one must be done with it.
+func (e *Event) Reset() {
+	if eventDebug && atomic.LoadInt32(&e.sleepers) != 0 {
+		panic("gsysint: Event reset with a sleeper")
+	}
+	g.NoteClear(&e.note)
+	atomic.StoreUint32(&e.signaled, 0)
+}
+
+func (e *Event) sleep(ns int64) bool {
+	if eventDebug && atomic.AddInt32(&e.sleepers, 1) != 1 {
+		atomic.AddInt32(&e.sleepers, -1)
+		panic("gsysint: Event has another sleeper")
+	}
+	ok := g.NoteTSleepG(&e.note, ns)
+	if eventDebug {
+		atomic.AddInt32(&e.sleepers, -1)
+	}
+	return ok
+}
@@ -0,0 +1,7 @@
+//go:build gsysintdebug
+// +build gsysintdebug
+
+package gsysint
+
+// eventDebug checks the Event contract.
+const eventDebug = true
@@ -0,0 +1,46 @@
+//go:build gsysintdebug
+// +build gsysintdebug
+
+package gsysint
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func mustPanic(t *testing.T, what string, f func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("no panic on %s", what)
+		}
+	}()
+	f()
+}
+
+func TestEventContract(t *testing.T) {
+	var e Event
+	e.Signal()
+	mustPanic(t, "a second Signal", e.Signal)
+
+	e.Reset()
+	started := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		close(started)
+		e.Wait()
+		close(done)
+	}()
+	<-started
+	for {
+		time.Sleep(time.Millisecond)
+		if atomic.LoadInt32(&e.sleepers) == 1 {
+			break
+		}
+	}
+	mustPanic(t, "a second sleeper", e.Wait)
+	mustPanic(t, "a Reset under a sleeper", e.Reset)
+	e.Signal()
+	<-done
+}
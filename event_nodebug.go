@@ -0,0 +1,6 @@
+//go:build !gsysintdebug
+// +build !gsysintdebug
+
+package gsysint
+
+const eventDebug = false
@@ -0,0 +1,33 @@
+package gsysint
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEvent(t *testing.T) {
+	var e Event
+	if e.WaitTimeout(time.Millisecond) || e.Signaled() {
+		t.Fatal("a fresh Event is signaled")
+	}
+
+	done := make(chan struct{})
+	go func() {
+		e.Wait()
+		close(done)
+	}()
+	time.Sleep(time.Millisecond)
+	e.Signal()
+	<-done
+	if !e.Signaled() || !e.WaitTimeout(time.Hour) || !e.WaitTimeout(0) {
+		t.Fatal("a signaled Event does not return at once")
+	}
+
+	e.Reset()
+	e.Signal()
+	if !eventDebug {
+		// Instead of the runtime double wakeup throw.
+		e.Signal()
+	}
+	e.Wait()
+}
@@ -0,0 +1,154 @@
+package g
+
+import (
+	"unsafe"
+)
+
+// Read-only accessors of the mirror fields that keep their runtime
+// names. The mirrors are meant to be pointed at runtime memory, there
+// are no setters.
+
+// Lo returns the low bound of the stack.
+func (s *Stack) Lo() uintptr { return s.lo }
+
+// Hi returns the high bound of the stack, exclusive.
+func (s *Stack) Hi() uintptr { return s.hi }
+
+// SP returns the saved stack pointer.
+func (b *GoBuf) SP() uintptr { return b.sp }
+
+// PC returns the saved program counter.
+func (b *GoBuf) PC() uintptr { return b.pc }
+
+// G returns the goroutine the buffer belongs to.
+func (b *GoBuf) G() *G { return b.g.Ptr() }
+
+// Ctxt returns the saved closure context.
+func (b *GoBuf) Ctxt() unsafe.Pointer { return b.ctxt }
+
+// LR returns the saved link register, on the archs that have one.
+func (b *GoBuf) LR() uintptr { return b.lr }
+
+// BP returns the saved frame pointer.
+func (b *GoBuf) BP() uintptr { return b.bp }
+
+// G returns the waiting goroutine.
+func (s *Sudog) G() *G { return s.g }
+
+// Next returns the next sudog of the wait queue.
+func (s *Sudog) Next() *Sudog { return s.next }
+
+// Prev returns the previous sudog of the wait queue.
+func (s *Sudog) Prev() *Sudog { return s.prev }
+
+// AcquireTime returns the time the goroutine started waiting, if the
+// block profile is on.
+func (s *Sudog) AcquireTime() int64 { return s.acquiretime }
+
+// ReleaseTime returns the time the goroutine was released, if the block
+// profile is on.
+func (s *Sudog) ReleaseTime() int64 { return s.releasetime }
+
+// Ticket returns the ticket of the sudog, used by the select and the
+// semaphore tree.
+func (s *Sudog) Ticket() uint32 { return s.ticket }
+
+// Parent returns the parent of the sudog in the semaphore tree.
+func (s *Sudog) Parent() *Sudog { return s.parent }
+
+// WaitLink returns the next sudog of g.waiting or of the semaphore tree.
+func (s *Sudog) WaitLink() *Sudog { return s.waitlink }
+
+// WaitTail returns the tail of the semaphore tree list.
+func (s *Sudog) WaitTail() *Sudog { return s.waittail }
+
+// First returns the first sudog of the queue.
+func (q *WaitQ) First() *Sudog { return q.first }
+
+// Last returns the last sudog of the queue.
+func (q *WaitQ) Last() *Sudog { return q.last }
+
+// QCount returns the number of elements in the channel buffer.
+func (c *HChan) QCount() uint { return c.qcount }
+
+// DataQSiz returns the size of the channel buffer.
+func (c *HChan) DataQSiz() uint { return c.dataqsiz }
+
+// Buf returns the channel buffer, an array of DataQSiz elements.
+func (c *HChan) Buf() unsafe.Pointer { return c.buf }
+
+// ElemSize returns the size of the channel elements.
+func (c *HChan) ElemSize() uint16 { return c.elemsize }
+
+// Closed reports whether the channel is closed.
+func (c *HChan) Closed() bool { return c.closed != 0 }
+
+// ElemType returns the type of the channel elements.
+func (c *HChan) ElemType() *Type { return c.elemtype }
+
+// SendX returns the send index into the channel buffer.
+func (c *HChan) SendX() uint { return c.sendx }
+
+// RecvX returns the receive index into the channel buffer.
+func (c *HChan) RecvX() uint { return c.recvx }
+
+// RecvQ returns the list of the receivers blocked on the channel.
+// It must be read under the channel lock.
+func (c *HChan) RecvQ() *WaitQ { return &c.recvq }
+
+// SendQ returns the list of the senders blocked on the channel.
+// It must be read under the channel lock.
+func (c *HChan) SendQ() *WaitQ { return &c.sendq }
+
+// SP returns the stack pointer at the time of the defer.
+func (d *Defer) SP() uintptr { return d.sp }
+
+// PC returns the program counter at the time of the defer.
+func (d *Defer) PC() uintptr { return d.pc }
+
+// Link returns the next defer of the goroutine.
+func (d *Defer) Link() *Defer { return d.link }
+
+// Arg returns the argument of panic.
+func (p *Panic) Arg() interface{} { return p.arg }
+
+// Link returns the earlier panic.
+func (p *Panic) Link() *Panic { return p.link }
+
+// Recovered reports whether the panic has been recovered.
+func (p *Panic) Recovered() bool { return p.recovered }
+
+// Fn returns the function of the frame.
+func (f *StkFrame) Fn() *Func { return f.fn.Func }
+
+// PC returns the program counter within Fn.
+func (f *StkFrame) PC() uintptr { return f.pc }
+
+// ContinPC returns the program counter where execution can continue,
+// or 0 if not.
+func (f *StkFrame) ContinPC() uintptr { return f.continpc }
+
+// LR returns the program counter at the caller, aka link register.
+func (f *StkFrame) LR() uintptr { return f.lr }
+
+// SP returns the stack pointer at PC.
+func (f *StkFrame) SP() uintptr { return f.sp }
+
+// FP returns the stack pointer at the caller, aka frame pointer.
+func (f *StkFrame) FP() uintptr { return f.fp }
+
+// VarP returns the top of the local variables.
+func (f *StkFrame) VarP() uintptr { return f.varp }
+
+// ArgP returns the pointer to the function arguments.
+func (f *StkFrame) ArgP() uintptr { return f.argp }
+
+// EntryOff returns the start pc of the function, as an offset from the
+// text of its module.
+func (f *Func) EntryOff() uint32 { return f.entryOff }
+
+// Args returns the size of the in/out arguments.
+func (f *Func) Args() int32 { return f.args }
+
+// StartLine returns the line of the func keyword or TEXT directive.
+func (f *Func) StartLine() int32 { return f.startLine }
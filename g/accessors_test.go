@@ -0,0 +1,102 @@
+package g
+
+import (
+	"reflect"
+	"runtime"
+	"testing"
+	"unsafe"
+)
+
+func TestStackBounds(t *testing.T) {
+	var local byte
+	sp := uintptr(unsafe.Pointer(&local))
+	s := &CurG().Stack
+	if sp < s.Lo() || sp >= s.Hi() {
+		t.Fatalf("%#x is not in the stack [%#x, %#x)", sp, s.Lo(), s.Hi())
+	}
+}
+
+func TestHChanAccessors(t *testing.T) {
+	ch := make(chan int64, 4)
+	ch <- 1
+	ch <- 2
+	c := *(**HChan)(unsafe.Pointer(&ch))
+	if c.QCount() != 2 || c.DataQSiz() != 4 || c.ElemSize() != 8 || c.SendX() != 2 || c.RecvX() != 0 {
+		t.Fatalf("qcount %d, dataqsiz %d, elemsize %d, sendx %d, recvx %d",
+			c.QCount(), c.DataQSiz(), c.ElemSize(), c.SendX(), c.RecvX())
+	}
+	if c.Closed() {
+		t.Fatal("open channel is closed")
+	}
+	close(ch)
+	if !c.Closed() {
+		t.Fatal("closed channel is not closed")
+	}
+}
+
+func TestSudogAccessors(t *testing.T) {
+	ch := make(chan int)
+	c := *(**HChan)(unsafe.Pointer(&ch))
+	ids := make(chan uint64)
+	go func() {
+		ids <- uint64(CurG().GoID)
+		<-ch
+	}()
+	id := <-ids
+
+	var s *Sudog
+	for i := 0; i < 1000 && s == nil; i++ {
+		runtime.Gosched()
+		Lock(&c.lock)
+		s = c.RecvQ().First()
+		Unlock(&c.lock)
+	}
+	if s == nil {
+		t.Fatal("no receiver parked on the channel")
+	}
+	if uint64(s.G().GoID) != id {
+		t.Errorf("sudog of goroutine %d, want %d", s.G().GoID, id)
+	}
+	if s.C() != c {
+		t.Errorf("sudog channel %p, want %p", s.C(), c)
+	}
+	if s.G().Sched.SP() < s.G().Stack.Lo() || s.G().Sched.SP() >= s.G().Stack.Hi() {
+		t.Errorf("parked sp %#x is not on the goroutine stack", s.G().Sched.SP())
+	}
+	if s.G().Sched.G() != s.G() {
+		t.Error("sched.g is not the goroutine")
+	}
+	ch <- 1
+}
+
+func TestPanicAccessors(t *testing.T) {
+	defer func() {
+		p := CurG().Panic
+		if p == nil || p.Arg() != "boom" || p.Recovered() {
+			t.Error("current panic is not the unrecovered boom")
+		}
+		recover()
+	}()
+	panic("boom")
+}
+
+// funcStart returns the line of its func keyword. Its array argument
+// is passed on the stack by every ABI.
+//
+//go:noinline
+func funcStart(_ [2]uintptr) int {
+	_, _, line, _ := runtime.Caller(0)
+	return line - 1
+}
+
+func TestFuncAccessors(t *testing.T) {
+	// The *runtime.Func of a function that is not inlined is its _func.
+	rf := runtime.FuncForPC(reflect.ValueOf(funcStart).Pointer())
+	f := (*Func)(unsafe.Pointer(rf))
+	if got, want := f.StartLine(), int32(funcStart([2]uintptr{})); got != want {
+		t.Errorf("start line %d, want %d", got, want)
+	}
+	if got, min := f.Args(), int32(2*unsafe.Sizeof(uintptr(0))); got < min {
+		t.Errorf("args size %d, want at least %d", got, min)
+	}
+}
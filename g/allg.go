@@ -0,0 +1,91 @@
+package g
+
+import (
+	_ "unsafe"
+)
+
+//go:linkname allgs runtime.allgs
+var allgs []*G
+
+//go:linkname allglock runtime.allglock
+var allglock Mutex
+
+// GInfo is a copy of the scheduling state of a goroutine.
+type GInfo struct {
+	ID         uint64
+	Status     GStatus
+	WaitReason WaitReason // if Status is GWaiting
+	WaitSince  int64      // approx time when the goroutine became blocked
+	GoPC       uintptr    // pc of the go statement that created the goroutine
+	StartPC    uintptr    // pc of the goroutine function
+	LockedM    int64      // id of the M the goroutine is locked to, -1 if none
+}
+
+// snapshotAllGs copies runtime.allgs under allglock into buf.
+//
+// No user code may run with allglock held: it is a runtime lock, a
+// goroutine holding it can not be preempted nor park.
+func snapshotAllGs(buf []*G) []*G {
+	for {
+		// The unlocked len is a hint to size buf before taking the lock.
+		if n := len(allgs); cap(buf) < n {
+			buf = make([]*G, 0, n+n/4+8)
+		}
+		Lock(&allglock)
+		if len(allgs) <= cap(buf) {
+			buf = append(buf[:0], allgs...)
+			Unlock(&allglock)
+			return buf
+		}
+		Unlock(&allglock)
+	}
+}
+
+// ForEachG calls fn for every live (not GDead) goroutine until fn
+// returns false. It does nothing if the layouts failed Verify.
+//
+// The list of goroutines is copied under runtime allglock, fn is called
+// after it is released. The goroutines keep running meanwhile, so
+// everything but the identity of a G is a racy read. G structures are
+// never freed but they are reused: a G seen as dead may host a new
+// goroutine by the time fn looks at it.
+func ForEachG(fn func(gp *G) bool) {
+	if !Verified() {
+		return
+	}
+	for _, gp := range snapshotAllGs(nil) {
+		if s := gp.Status().Base(); s == GDead || s == GDeadExtra {
+			continue
+		}
+		if !fn(gp) {
+			return
+		}
+	}
+}
+
+// AllGs returns the scheduling state of every live goroutine, without
+// formatting their stacks. It returns nil if the layouts failed Verify.
+func AllGs() []GInfo {
+	var gs []GInfo
+	ForEachG(func(gp *G) bool {
+		gs = append(gs, gInfo(gp))
+		return true
+	})
+	return gs
+}
+
+func gInfo(gp *G) GInfo {
+	info := GInfo{
+		ID:         uint64(gp.GoID),
+		Status:     gp.Status(),
+		WaitReason: gp.WaitReason,
+		WaitSince:  gp.WaitSince,
+		GoPC:       gp.GoPC,
+		StartPC:    gp.StartPC,
+		LockedM:    -1,
+	}
+	if mp := gp.LockedM.Ptr(); mp != nil {
+		info.LockedM = mp.ID
+	}
+	return info
+}
@@ -0,0 +1,94 @@
+package g
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+)
+
+func parked(n int) (ids chan uint64, stop func()) {
+	ids = make(chan uint64, n)
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ids <- uint64(CurG().GoID)
+			<-done
+		}()
+	}
+	return ids, func() { close(done); wg.Wait() }
+}
+
+func TestAllGs(t *testing.T) {
+	const n = 16
+	ids, stop := parked(n)
+	defer stop()
+
+	want := make(map[uint64]bool, n)
+	for i := 0; i < n; i++ {
+		want[<-ids] = true
+	}
+
+	self := uint64(CurG().GoID)
+	for try := 0; len(want) > 0; try++ {
+		if try == 1000 {
+			t.Fatalf("goroutines %v are not parked in AllGs", want)
+		}
+		runtime.Gosched()
+
+		var found bool
+		for _, gi := range AllGs() {
+			if gi.ID == self {
+				found = true
+				if gi.Status != GRunning {
+					t.Fatalf("current goroutine status = %v, want %v", gi.Status, GRunning)
+				}
+			}
+			if !want[gi.ID] || gi.Status != GWaiting {
+				continue
+			}
+			if gi.WaitReason != WaitReasonChanReceive {
+				t.Fatalf("goroutine %d wait reason = %v, want %v", gi.ID, gi.WaitReason, WaitReasonChanReceive)
+			}
+			if gi.LockedM != -1 {
+				t.Fatalf("goroutine %d locked to M %d", gi.ID, gi.LockedM)
+			}
+			if fn := runtime.FuncForPC(gi.GoPC); fn == nil || fn.Name() != "github.com/sitano/gsysint/g.parked" {
+				t.Fatalf("goroutine %d gopc in %v, want parked", gi.ID, fn)
+			}
+			delete(want, gi.ID)
+		}
+		if !found {
+			t.Fatal("current goroutine not found")
+		}
+	}
+}
+
+func TestForEachGStop(t *testing.T) {
+	var calls int
+	ForEachG(func(*G) bool {
+		calls++
+		return false
+	})
+	if calls != 1 {
+		t.Fatalf("fn called %d times after returning false", calls)
+	}
+}
+
+func TestAllGsLockedM(t *testing.T) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	self := uint64(CurG().GoID)
+	for _, gi := range AllGs() {
+		if gi.ID == self {
+			if want := CurM().ID; gi.LockedM != want {
+				t.Fatalf("LockedM = %d, want %d", gi.LockedM, want)
+			}
+			return
+		}
+	}
+	t.Fatal("current goroutine not found")
+}
@@ -0,0 +1,135 @@
+package g
+
+import (
+	"sync/atomic"
+	_ "unsafe"
+)
+
+//go:linkname allm runtime.allm
+var allm *M
+
+//go:linkname allp runtime.allp
+var allp []*P
+
+// MInfo is a copy of the scheduling state of an M.
+type MInfo struct {
+	ID       int64
+	ProcID   uint64 // OS thread id
+	CurG     uint64 // goid of the running goroutine, 0 if none
+	Spinning bool   // looking for work
+	Blocked  bool   // blocked on a note
+	LockedG  uint64 // goid of the goroutine locked to the M, 0 if none
+	NCgoCall uint64 // number of cgo calls in total
+	P        int32  // id of the attached P, -1 if none
+}
+
+// PInfo is a copy of the scheduling state of a P.
+type PInfo struct {
+	ID          int32
+	Status      PStatus
+	SchedTick   uint32 // incremented on every scheduler call
+	SysCallTick uint32 // incremented on every system call
+	RunqSize    uint32 // length of the local run queue, runnext aside
+	M           int64  // id of the attached M, -1 if none
+}
+
+// AllMs returns the state of every M of runtime.allm, the way
+// GODEBUG=schedtrace=X,scheddetail=1 prints it. It returns nil if the
+// layouts failed Verify.
+//
+// allm is walked under sched.lock, the records are racy reads of Ms
+// that keep running meanwhile.
+func AllMs() []MInfo {
+	if !Verified() {
+		return nil
+	}
+	// No allocation is allowed under a runtime lock, the records go to a
+	// buffer sized beforehand.
+	ms := make([]MInfo, 0, 16)
+	for {
+		Lock(&sched.Lock)
+		n := 0
+		for mp := allm; mp != nil; mp = mp.AllLink {
+			if n < cap(ms) {
+				ms = append(ms, mInfo(mp))
+			}
+			n++
+		}
+		Unlock(&sched.Lock)
+		if n <= cap(ms) {
+			return ms
+		}
+		ms = make([]MInfo, 0, n+n/4)
+	}
+}
+
+func mInfo(mp *M) MInfo {
+	info := MInfo{
+		ID:       mp.ID,
+		ProcID:   mp.ProcID,
+		Spinning: mp.Spinning,
+		Blocked:  mp.Blocked,
+		NCgoCall: mp.NCgoCall,
+		P:        -1,
+	}
+	if gp := mp.CurG; gp != nil {
+		info.CurG = uint64(gp.GoID)
+	}
+	if gp := mp.LockedG.Ptr(); gp != nil {
+		info.LockedG = uint64(gp.GoID)
+	}
+	if pp := mp.P.Ptr(); pp != nil {
+		info.P = pp.ID
+	}
+	return info
+}
+
+// AllPs returns the state of every P of runtime.allp, that is of
+// GOMAXPROCS Ps. It returns nil if the layouts failed Verify.
+func AllPs() []PInfo {
+	if !Verified() {
+		return nil
+	}
+	ps := make([]PInfo, 0, 8)
+	for {
+		lockAllP()
+		n := len(allp)
+		if n <= cap(ps) {
+			for _, pp := range allp {
+				ps = append(ps, pInfo(pp))
+			}
+		}
+		unlockAllP()
+		if n <= cap(ps) {
+			return ps
+		}
+		ps = make([]PInfo, 0, n)
+	}
+}
+
+func pInfo(pp *P) PInfo {
+	info := PInfo{
+		ID:          pp.ID,
+		Status:      pp.Status,
+		SchedTick:   pp.SchedTick,
+		SysCallTick: pp.SysCallTick,
+		RunqSize:    runqSize(pp),
+		M:           -1,
+	}
+	if mp := pp.M.Ptr(); mp != nil {
+		info.M = mp.ID
+	}
+	return info
+}
+
+// runqSize returns the length of the local run queue of pp. The head is
+// loaded before the tail, as in the runtime: a head loaded after the tail
+// may have moved past it, and the length would wrap around.
+func runqSize(pp *P) uint32 {
+	head := atomic.LoadUint32(&pp.RunqHead)
+	tail := atomic.LoadUint32(&pp.RunqTail)
+	if n := tail - head; n <= uint32(len(pp.Runq)) {
+		return n
+	}
+	return uint32(len(pp.Runq))
+}
@@ -0,0 +1,64 @@
+package g
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestAllMs(t *testing.T) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	self := CurM()
+	for _, mi := range AllMs() {
+		if mi.ID != self.ID {
+			continue
+		}
+		if id := uint64(CurG().GoID); mi.CurG != id || mi.LockedG != id {
+			t.Errorf("CurG = %d, LockedG = %d, want %d", mi.CurG, mi.LockedG, id)
+		}
+		if p := CurP(); p == nil || mi.P != p.ID {
+			t.Errorf("P = %d, want the current P", mi.P)
+		}
+		if mi.Blocked {
+			t.Error("current M is blocked")
+		}
+		return
+	}
+	t.Fatal("current M not found")
+}
+
+func TestAllPs(t *testing.T) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	ps := AllPs()
+	if len(ps) != runtime.GOMAXPROCS(0) {
+		t.Fatalf("%d Ps, want GOMAXPROCS %d", len(ps), runtime.GOMAXPROCS(0))
+	}
+	self := CurP()
+	for i, pi := range ps {
+		if pi.ID != int32(i) {
+			t.Errorf("allp[%d].ID = %d", i, pi.ID)
+		}
+		if pi.ID == self.ID && (pi.Status != PRunning || pi.M != CurM().ID) {
+			t.Errorf("current P is %v on M %d, want running on M %d", pi.Status, pi.M, CurM().ID)
+		}
+		if pi.RunqSize > uint32(len(self.Runq)) {
+			t.Errorf("allp[%d].RunqSize = %d", i, pi.RunqSize)
+		}
+	}
+}
+
+func TestRunqSize(t *testing.T) {
+	var pp P
+	pp.RunqHead, pp.RunqTail = 7, 10
+	if n := runqSize(&pp); n != 3 {
+		t.Errorf("runqSize = %d, want 3", n)
+	}
+	// A head moved past the tail loaded before it.
+	pp.RunqHead, pp.RunqTail = 10, 7
+	if n := runqSize(&pp); n != uint32(len(pp.Runq)) {
+		t.Errorf("runqSize = %d, want %d", n, len(pp.Runq))
+	}
+}
@@ -0,0 +1,16 @@
+//go:build go1.16
+// +build go1.16
+
+package g
+
+import (
+	_ "unsafe"
+)
+
+// allpLock protects P-less reads and size changes of allp.
+//
+//go:linkname allpLock runtime.allpLock
+var allpLock Mutex
+
+func lockAllP()   { Lock(&allpLock) }
+func unlockAllP() { Unlock(&allpLock) }
@@ -0,0 +1,33 @@
+// Stubs shared by the asm_go1*_386.s files, which include the
+// go_asm header of their release first.
+
+#include "textflag.h"
+
+#define	get_tls(r)	MOVL TLS, r
+#define	g(r)	0(r)(TLS*1)
+
+TEXT ·GetG(SB),NOSPLIT,$0-4
+	get_tls(CX)
+	MOVL	g(CX), AX
+	MOVL	AX, ret+0(FP)
+	RET
+
+TEXT ·GetM(SB),NOSPLIT,$0-4
+	get_tls(CX)
+	MOVL	g(CX), AX
+	MOVL	g_m(AX), BX
+	MOVL	BX, ret+0(FP)
+	RET
+
+// func asmLayout() (gSize, gM, gGoID, gAtomicStatus, gLockedM, mSize, mG0, mCurG, mLockedG uintptr)
+TEXT ·asmLayout(SB),NOSPLIT,$0-36
+	MOVL	$g__size, gSize+0(FP)
+	MOVL	$g_m, gM+4(FP)
+	MOVL	$g_goid, gGoID+8(FP)
+	MOVL	$g_atomicstatus, gAtomicStatus+12(FP)
+	MOVL	$g_lockedm, gLockedM+16(FP)
+	MOVL	$m__size, mSize+20(FP)
+	MOVL	$m_g0, mG0+24(FP)
+	MOVL	$m_curg, mCurG+28(FP)
+	MOVL	$m_lockedg, mLockedG+32(FP)
+	RET
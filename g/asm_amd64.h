@@ -0,0 +1,33 @@
+// Stubs shared by the asm_go1*_amd64.s files, which include the
+// go_asm header of their release first.
+
+#include "textflag.h"
+
+#define	get_tls(r)	MOVQ TLS, r
+#define	g(r)	0(r)(TLS*1)
+
+TEXT ·GetG(SB),NOSPLIT,$0-8
+	get_tls(CX)
+	MOVQ	g(CX), AX
+	MOVQ	AX, ret+0(FP)
+	RET
+
+TEXT ·GetM(SB),NOSPLIT,$0-8
+	get_tls(CX)
+	MOVQ	g(CX), AX
+	MOVQ	g_m(AX), BX
+	MOVQ	BX, ret+0(FP)
+	RET
+
+// func asmLayout() (gSize, gM, gGoID, gAtomicStatus, gLockedM, mSize, mG0, mCurG, mLockedG uintptr)
+TEXT ·asmLayout(SB),NOSPLIT,$0-72
+	MOVQ	$g__size, gSize+0(FP)
+	MOVQ	$g_m, gM+8(FP)
+	MOVQ	$g_goid, gGoID+16(FP)
+	MOVQ	$g_atomicstatus, gAtomicStatus+24(FP)
+	MOVQ	$g_lockedm, gLockedM+32(FP)
+	MOVQ	$m__size, mSize+40(FP)
+	MOVQ	$m_g0, mG0+48(FP)
+	MOVQ	$m_curg, mCurG+56(FP)
+	MOVQ	$m_lockedg, mLockedG+64(FP)
+	RET
@@ -0,0 +1,5 @@
+//go:build go1.20 && !go1.21
+// +build go1.20,!go1.21
+
+#include "go_asm_go120_amd64.h"
+#include "asm_amd64.h"
@@ -0,0 +1,5 @@
+//go:build go1.21 && !go1.22
+// +build go1.21,!go1.22
+
+#include "go_asm_go121_amd64.h"
+#include "asm_amd64.h"
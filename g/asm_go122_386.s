@@ -0,0 +1,5 @@
+//go:build go1.22 && !go1.23
+// +build go1.22,!go1.23
+
+#include "go_asm_go122_386.h"
+#include "asm_386.h"
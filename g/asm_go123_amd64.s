@@ -0,0 +1,5 @@
+//go:build go1.23 && !go1.24
+// +build go1.23,!go1.24
+
+#include "go_asm_go123_amd64.h"
+#include "asm_amd64.h"
@@ -0,0 +1,5 @@
+//go:build go1.24 && !go1.25
+// +build go1.24,!go1.25
+
+#include "go_asm_go124_amd64.h"
+#include "asm_amd64.h"
@@ -0,0 +1,5 @@
+//go:build go1.25 && !go1.26
+// +build go1.25,!go1.26
+
+#include "go_asm_go125_amd64.h"
+#include "asm_amd64.h"
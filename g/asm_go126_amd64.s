@@ -0,0 +1,5 @@
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
+
+#include "go_asm_go126_amd64.h"
+#include "asm_amd64.h"
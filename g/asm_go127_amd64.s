@@ -0,0 +1,5 @@
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+#include "go_asm_go127_amd64.h"
+#include "asm_amd64.h"
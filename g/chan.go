@@ -27,27 +27,6 @@ const (
 	debugChan = false
 )
 
-type HChan struct {
-	qcount   uint           // total data in the queue
-	dataqsiz uint           // size of the circular queue
-	buf      unsafe.Pointer // points to an array of dataqsiz elements
-	elemsize uint16
-	closed   uint32
-	elemtype *Type // element type
-	sendx    uint  // send index
-	recvx    uint  // receive index
-	recvq    WaitQ // list of recv waiters
-	sendq    WaitQ // list of send waiters
-
-	// lock protects all fields in hchan, as well as several
-	// fields in sudogs blocked on this channel.
-	//
-	// Do not change another G's status while holding this lock
-	// (in particular, do not ready a G), as this can deadlock
-	// with stack shrinking.
-	lock Mutex
-}
-
 type WaitQ struct {
 	first *Sudog
 	last  *Sudog
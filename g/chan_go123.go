@@ -0,0 +1,8 @@
+//go:build go1.23
+// +build go1.23
+
+package g
+
+// Timer returns the runtime timer feeding c, nil if c is not the channel
+// of a time.Timer or a time.Ticker.
+func (c *HChan) Timer() *Timer { return c.timer }
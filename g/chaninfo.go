@@ -0,0 +1,90 @@
+package g
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// ChanState is a copy of the state of a channel.
+type ChanState struct {
+	Len      int     // elements in the buffer
+	Cap      int     // size of the buffer
+	Closed   bool    // the channel is closed
+	ElemSize uintptr // size of the elements
+	SendX    uint    // buffer index of the next send
+	RecvX    uint    // buffer index of the next receive
+
+	// RecvQ and SendQ are the ids of the goroutines parked on receive
+	// and send, in queue order. A goroutine in a select is listed for
+	// every case on the channel.
+	RecvQ []uint64
+	SendQ []uint64
+}
+
+// HChanOf returns the runtime channel of ch, nil if ch is a nil channel.
+// It panics if ch is not a channel.
+func HChanOf(ch interface{}) *HChan {
+	if t := reflect.TypeOf(ch); t == nil || t.Kind() != reflect.Chan {
+		panic("g: HChanOf of a non-channel")
+	}
+	// A channel is a pointer to its hchan, stored in the interface data word.
+	return (*HChan)((*[2]unsafe.Pointer)(unsafe.Pointer(&ch))[1])
+}
+
+// ChanInfo copies the state of the channel ch under its lock. It returns
+// false if ch is a nil channel or the layouts failed Verify, and panics
+// if ch is not a channel.
+func ChanInfo(ch interface{}) (ChanState, bool) {
+	c := HChanOf(ch)
+	if c == nil || !Verified() {
+		return ChanState{}, false
+	}
+	return c.State(), true
+}
+
+// State copies the state of c under its lock.
+//
+// The wait queues are copied into buffers sized before the lock is taken,
+// nothing may allocate under a runtime lock.
+func (c *HChan) State() ChanState {
+	var recvq, sendq []uint64
+	for {
+		Lock(&c.lock)
+		nr, ns := c.recvq.len(), c.sendq.len()
+		if nr <= cap(recvq) && ns <= cap(sendq) {
+			s := ChanState{
+				Len:      int(c.qcount),
+				Cap:      int(c.dataqsiz),
+				Closed:   c.closed != 0,
+				ElemSize: uintptr(c.elemsize),
+				SendX:    c.sendx,
+				RecvX:    c.recvx,
+				RecvQ:    c.recvq.goids(recvq),
+				SendQ:    c.sendq.goids(sendq),
+			}
+			Unlock(&c.lock)
+			return s
+		}
+		Unlock(&c.lock)
+		recvq, sendq = make([]uint64, 0, nr+4), make([]uint64, 0, ns+4)
+	}
+}
+
+func (q *WaitQ) len() int {
+	n := 0
+	for s := q.first; s != nil; s = s.next {
+		n++
+	}
+	return n
+}
+
+// goids appends the goroutine ids of q to buf, which must fit them.
+func (q *WaitQ) goids(buf []uint64) []uint64 {
+	if q.first == nil {
+		return nil
+	}
+	for s := q.first; s != nil; s = s.next {
+		buf = append(buf, uint64(s.g.GoID))
+	}
+	return buf
+}
@@ -0,0 +1,97 @@
+package g
+
+import (
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+// queued starts n goroutines parking on ch, each after the previous one
+// shows in q, and returns the ids of q in order.
+func queued(t *testing.T, ch interface{}, q func(ChanState) []uint64, n int, start func()) []uint64 {
+	for i := 0; i < n; i++ {
+		start()
+		for try := 0; ; try++ {
+			if try == 10000 {
+				t.Fatalf("%d goroutines are not parked on the channel", i+1)
+			}
+			if s, _ := ChanInfo(ch); len(q(s)) == i+1 {
+				break
+			}
+			runtime.Gosched()
+		}
+	}
+	s, _ := ChanInfo(ch)
+	return q(s)
+}
+
+func TestChanInfo(t *testing.T) {
+	if _, ok := ChanInfo((chan int)(nil)); ok {
+		t.Fatal("ChanInfo of a nil channel")
+	}
+
+	ch := make(chan int64, 4)
+	ch <- 1
+	ch <- 2
+	<-ch
+	s, ok := ChanInfo(ch)
+	if !ok {
+		t.Skip("layouts failed Verify")
+	}
+	want := ChanState{Len: 1, Cap: 4, ElemSize: 8, SendX: 2, RecvX: 1}
+	if !reflect.DeepEqual(s, want) {
+		t.Fatalf("ChanInfo = %+v, want %+v", s, want)
+	}
+
+	recvq := func(s ChanState) []uint64 { return s.RecvQ }
+	sendq := func(s ChanState) []uint64 { return s.SendQ }
+
+	unbuf := make(chan struct{})
+	ids := make(chan uint64, 3)
+	recv := queued(t, unbuf, recvq, 3, func() {
+		go func() {
+			ids <- uint64(CurG().GoID)
+			<-unbuf
+		}()
+	})
+	for i, id := range recv {
+		if want := <-ids; id != want {
+			t.Fatalf("recvq[%d] = %d, want goroutine %d", i, id, want)
+		}
+	}
+	close(unbuf)
+	if s, _ := ChanInfo(unbuf); !s.Closed || s.RecvQ != nil {
+		t.Fatalf("ChanInfo of the closed channel = %+v", s)
+	}
+
+	ch <- 3
+	ch <- 4
+	ch <- 5
+	done := make(chan struct{})
+	send := queued(t, ch, sendq, 2, func() {
+		go func() {
+			ids <- uint64(CurG().GoID)
+			ch <- 0
+			done <- struct{}{}
+		}()
+	})
+	for i, id := range send {
+		if want := <-ids; id != want {
+			t.Fatalf("sendq[%d] = %d, want goroutine %d", i, id, want)
+		}
+	}
+	for i := 0; i < 6; i++ {
+		<-ch
+	}
+	<-done
+	<-done
+}
+
+func TestHChanOf(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("HChanOf of an int did not panic")
+		}
+	}()
+	HChanOf(1)
+}
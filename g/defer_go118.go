@@ -0,0 +1,11 @@
+//go:build go1.18
+// +build go1.18
+
+package g
+
+import (
+	"unsafe"
+)
+
+// Fn returns the deferred function, nil for the open-coded defers.
+func (d *Defer) Fn() *FuncVal { return *(**FuncVal)(unsafe.Pointer(&d.fn)) }
@@ -0,0 +1,9 @@
+package g
+
+// AsmLayout is asmLayout for the tests out of the package.
+var AsmLayout = asmLayout
+
+// SetAsmOffsets makes Verify check the mirrors against the offsets of f.
+func SetAsmOffsets(f func() (gSize, gM, gGoID, gAtomicStatus, gLockedM, mSize, mG0, mCurG, mLockedG uintptr)) {
+	asmOffsets = f
+}
@@ -1,4 +1,4 @@
-// Package g provides access to the runtime structures of go1.12.5
+// Package g provides access to the runtime structures of go1.20 - go1.27
 // that participate in organization of goroutines scheduling.
 //
 // On scheduling check:
@@ -13,7 +13,12 @@
 //       blocked or in a syscall w/o an associated P.
 package g
 
-import "unsafe"
+//go:generate go run ./gen
+
+import (
+	"sync/atomic"
+	"unsafe"
+)
 
 // GetGPtr returns the pointer to the current g.
 // The compiler rewrites calls to this function into instructions
@@ -26,11 +31,37 @@ func GetM() unsafe.Pointer
 // CurG returns the pointer to the current g.
 // The compiler rewrites calls to this function into instructions
 // that fetch the g directly (from TLS or from the dedicated register).
+// It returns nil if the G layout failed Verify.
 func CurG() *G {
+	if atomic.LoadUint32(&disabled) != 0 {
+		return nil
+	}
 	return (*G)(GetG())
 }
 
 // CurM returns the pointer to the current m.
+// It returns nil if the M layout failed Verify.
 func CurM() *M {
+	if atomic.LoadUint32(&disabled) != 0 {
+		return nil
+	}
 	return (*M)(GetM())
-}
\ No newline at end of file
+}
+
+// CurP returns the pointer to the P the current m is running with, or nil
+// if it has none or the layouts failed Verify.
+//
+// The goroutine can be moved to another P at any preemption point, so
+// the result is only a hint unless preemption is otherwise ruled out.
+func CurP() *P {
+	mp := CurM()
+	if mp == nil {
+		return nil
+	}
+	return mp.P.Ptr()
+}
+
+// Status returns the status of the goroutine, loaded atomically.
+func (gp *G) Status() GStatus {
+	return GStatus(atomic.LoadUint32(&gp.AtomicStatus))
+}
@@ -1,6 +1,7 @@
 package g
 
 import (
+	"runtime"
 	"testing"
 )
 
@@ -29,3 +30,67 @@ func TestGetM(t *testing.T) {
 	t.Log("*m =", m)
 	// t.Log("m =", fmt.Sprintf("%#v", (*M)(m)))
 }
+
+func TestCurP(t *testing.T) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	p := CurP()
+	if p == nil {
+		t.Fatal("CurP() returned nil")
+	}
+	if p != CurM().P.Ptr() {
+		t.Fatal("CurP() is not the P of the current m")
+	}
+	if p.Status != PRunning {
+		t.Fatalf("p.Status = %v, want %v", p.Status, PRunning)
+	}
+	if n := runtime.GOMAXPROCS(0); p.ID < 0 || int(p.ID) >= n {
+		t.Fatalf("p.ID = %d, want in [0, %d)", p.ID, n)
+	}
+	if p.M.Ptr() != CurM() {
+		t.Fatal("p.M is not the current m")
+	}
+}
+
+func TestPStatusString(t *testing.T) {
+	for s, want := range map[PStatus]string{
+		PIdle:    "idle",
+		PRunning: "running",
+		PDead:    "dead",
+		42:       "unknown P status",
+	} {
+		if got := s.String(); got != want {
+			t.Errorf("PStatus(%d).String() = %q, want %q", uint32(s), got, want)
+		}
+	}
+}
+
+func TestGStatus(t *testing.T) {
+	for _, c := range []struct {
+		s    GStatus
+		str  string
+		scan bool
+		base GStatus
+	}{
+		{GRunning, "running", false, GRunning},
+		{GWaiting, "waiting", false, GWaiting},
+		{GScanWaiting, "scanwaiting", true, GWaiting},
+		{GScanRunnable, "scanrunnable", true, GRunnable},
+		{GScan | 42, "unknown G status", true, 42},
+	} {
+		if got := c.s.String(); got != c.str {
+			t.Errorf("%#x.String() = %q, want %q", uint32(c.s), got, c.str)
+		}
+		if got := c.s.IsScan(); got != c.scan {
+			t.Errorf("%#x.IsScan() = %v, want %v", uint32(c.s), got, c.scan)
+		}
+		if got := c.s.Base(); got != c.base {
+			t.Errorf("%#x.Base() = %#x, want %#x", uint32(c.s), uint32(got), uint32(c.base))
+		}
+	}
+
+	if s := CurG().Status(); s != GRunning {
+		t.Fatalf("CurG().Status() = %v, want %v", s, GRunning)
+	}
+}
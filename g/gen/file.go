@@ -0,0 +1,412 @@
+//go:build go1.21
+// +build go1.21
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// file is the runtime2_go1NN.go being generated.
+type file struct {
+	rt      *runtimeSrc
+	hdrs    map[string]header
+	version int
+
+	// arch, while fitting, renders the sizes for that arch only: the
+	// archs converge at their own pace and the sizes on the way need not
+	// be a*ptrSize+b yet.
+	arch string
+
+	byName map[string]*mirror
+	queue  []*mirror // in order of discovery
+}
+
+func newFile(rt *runtimeSrc, hdrs map[string]header, version int) *file {
+	return &file{rt: rt, hdrs: hdrs, version: version, byName: make(map[string]*mirror)}
+}
+
+// build collects the fields of the roots and of every struct they embed.
+func (f *file) build() error {
+	for _, r := range roots {
+		f.need(r)
+	}
+	for i := 0; i < len(f.queue); i++ {
+		m := f.queue[i]
+		if err := f.fields(m); err != nil {
+			return err
+		}
+		if err := f.sizes(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sizes records the runtime size of the opaque fields of m, which is up
+// to the offset of the next field.
+func (f *file) sizes(m *mirror) error {
+	for _, arch := range archs {
+		h := f.hdrs[arch]
+		end, ok := h.size(m.rt)
+		if !ok {
+			return fmt.Errorf("%s: no runtime.%s in go_asm.h", arch, m.rt)
+		}
+		if m.prefix && len(m.fields) > 0 {
+			last, _ := h.offset(m.rt, m.fields[len(m.fields)-1].rt)
+			end = h.next(m.rt, last, end)
+		}
+		for i := len(m.fields) - 1; i >= 0; i-- {
+			fd := m.fields[i]
+			off, ok := h.offset(m.rt, fd.rt)
+			if !ok {
+				return fmt.Errorf("%s: no runtime.%s.%s in go_asm.h", arch, m.rt, fd.rt)
+			}
+			if fd.size == nil {
+				fd.size = make(map[string]int64)
+				fd.pad = make(map[string]int64)
+			}
+			fd.size[arch] = end - off
+			if end > off {
+				end = off
+			}
+		}
+	}
+	return nil
+}
+
+// opaque returns the type of an opaque field of the given sizes by arch,
+// pointer aligned if it scales with the pointer size.
+func (f *file) opaque(size map[string]int64) (string, error) {
+	n64, n32 := size["amd64"], size["386"]
+	if n64 == 2*n32 && n32%4 == 0 && n32 > 0 {
+		return fmt.Sprintf("[%d]uintptr", n32/4), nil
+	}
+	n, err := f.archExpr(size)
+	if err != nil {
+		return "", err
+	}
+	return "[" + n + "]byte", nil
+}
+
+// archExpr returns a constant expression that evaluates to n[arch] on
+// every arch: a*unsafe.Sizeof(uintptr(0)) + b.
+func (f *file) archExpr(n map[string]int64) (string, error) {
+	if f.arch != "" {
+		return fmt.Sprint(n[f.arch]), nil
+	}
+	n64, n32 := n["amd64"], n["386"]
+	if n64 == n32 {
+		return fmt.Sprint(n64), nil
+	}
+	if (n64-n32)%4 != 0 {
+		return "", fmt.Errorf("size %d on amd64 and %d on 386 is not a*ptrSize+b", n64, n32)
+	}
+	a, b := (n64-n32)/4, 2*n32-n64
+	if a < 0 {
+		// The constant is a uintptr, keep it from going negative on the way.
+		return fmt.Sprintf("%d - %d*unsafe.Sizeof(uintptr(0))", b, -a), nil
+	}
+	s := fmt.Sprintf("%d*unsafe.Sizeof(uintptr(0))", a)
+	switch {
+	case b > 0:
+		s += fmt.Sprintf(" + %d", b)
+	case b < 0:
+		s += fmt.Sprintf(" - %d", -b)
+	}
+	return s, nil
+}
+
+// source renders the file.
+func (f *file) source() []byte {
+	src, err := f.render()
+	if err != nil {
+		panic(err)
+	}
+	return src
+}
+
+func (f *file) render() ([]byte, error) {
+	var body bytes.Buffer
+	for _, m := range f.queue {
+		if m.prefix {
+			fmt.Fprintf(&body, "// %s mirrors the leading fields of runtime.%s, up to %s.\n", m.name, m.rt, prefixes[m.rt])
+		}
+		fmt.Fprintf(&body, "type %s struct {\n", m.name)
+		for _, fd := range m.fields {
+			if fd.gap {
+				body.WriteByte('\n')
+			}
+			for _, l := range fd.doc {
+				body.WriteString(l + "\n")
+			}
+			if hasPad(fd.pad) {
+				n, err := f.archExpr(fd.pad)
+				if err != nil {
+					return nil, fmt.Errorf("%s.%s: padding: %v", m.name, fd.name, err)
+				}
+				fmt.Fprintf(&body, "_ [%s]byte\n", n)
+			}
+			typ := fd.typ
+			if fd.opaque {
+				t, err := f.opaque(fd.size)
+				if err != nil {
+					return nil, fmt.Errorf("%s.%s: %v", m.name, fd.name, err)
+				}
+				typ = t
+			}
+			switch {
+			case fd.embedded && !fd.opaque && typ == fd.name:
+				body.WriteString(typ)
+			default:
+				fmt.Fprintf(&body, "%s %s", fd.name, typ)
+			}
+			switch {
+			case fd.opaque:
+				fmt.Fprintf(&body, " // %s", fd.rtType)
+				if fd.comment != "" {
+					fmt.Fprintf(&body, ": %s", strings.TrimSpace(strings.TrimPrefix(fd.comment, "//")))
+				}
+			case fd.comment != "":
+				body.WriteString(" " + fd.comment)
+			}
+			body.WriteByte('\n')
+		}
+		if m.pad != 0 || m.pad32 != 0 {
+			n, err := f.archExpr(map[string]int64{"amd64": m.pad, "386": m.pad32})
+			if err != nil {
+				return nil, fmt.Errorf("%s: trailing padding: %v", m.name, err)
+			}
+			fmt.Fprintf(&body, "_ [%s]byte\n", n)
+		}
+		body.WriteString("}\n\n")
+	}
+
+	body.WriteString("// A waitReason explains why a goroutine has been stopped.\n")
+	body.WriteString("// See gopark. Do not re-use waitReasons, add new ones.\n")
+	body.WriteString("const (\n")
+	for i, w := range f.rt.waitReasons {
+		name := "WaitReason" + strings.TrimPrefix(w, "waitReason")
+		if i == 0 {
+			fmt.Fprintf(&body, "%s WaitReason = iota // %q\n", name, f.rt.waitReasonStrings[w])
+		} else {
+			fmt.Fprintf(&body, "%s // %q\n", name, f.rt.waitReasonStrings[w])
+		}
+	}
+	body.WriteString(")\n\nvar WaitReasonStrings = [...]string{\n")
+	for _, w := range f.rt.waitReasons {
+		if s, ok := f.rt.waitReasonStrings[w]; ok {
+			fmt.Fprintf(&body, "WaitReason%s: %q,\n", strings.TrimPrefix(w, "waitReason"), s)
+		}
+	}
+	body.WriteString("}\n")
+
+	var b bytes.Buffer
+	b.WriteString("// Copyright 2009 The Go Authors. All rights reserved.\n")
+	b.WriteString("// Use of this source code is governed by a BSD-style\n")
+	b.WriteString("// license that can be found in the LICENSE file.\n\n")
+	b.WriteString("// Code generated by go run ./gen; DO NOT EDIT.\n\n")
+	b.WriteString(buildTags(f.version))
+	b.WriteString("\npackage g\n\n")
+	var imports []string
+	if bytes.Contains(body.Bytes(), []byte("atomic.")) {
+		imports = append(imports, `"sync/atomic"`)
+	}
+	if bytes.Contains(body.Bytes(), []byte("unsafe.")) {
+		imports = append(imports, `"unsafe"`)
+	}
+	if len(imports) > 0 {
+		fmt.Fprintf(&b, "import (\n%s\n)\n\n", strings.Join(imports, "\n"))
+	}
+	fmt.Fprintf(&b, "// Layouts of the go1.%d runtime structures.\n\n", f.version)
+	b.Write(body.Bytes())
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%v\n%s", err, b.Bytes())
+	}
+	return src, nil
+}
+
+func hasPad(pad map[string]int64) bool {
+	for _, v := range pad {
+		if v != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// fit type checks package g with the generated file for every arch and
+// adjusts the mirrors until every field sits at its runtime offset.
+func (f *file) fit(dir string) error {
+	for iter := 0; ; iter++ {
+		if iter > 1000 {
+			return fmt.Errorf("layout does not converge")
+		}
+		changed := false
+		for _, arch := range archs {
+			f.arch = arch
+			src, err := f.render()
+			f.arch = ""
+			if err != nil {
+				return err
+			}
+			pkg, sizes, err := check(dir, arch, f.version, src)
+			if err != nil {
+				return err
+			}
+			c, err := f.adjust(arch, pkg, sizes)
+			if err != nil {
+				return err
+			}
+			changed = changed || c
+		}
+		if !changed {
+			return nil
+		}
+	}
+}
+
+// adjust fixes the first misplaced field found on arch. A field that is
+// too late gets its predecessor made opaque, a field that is too early
+// gets padding in front of it.
+func (f *file) adjust(arch string, pkg *types.Package, sizes types.Sizes) (bool, error) {
+	h := f.hdrs[arch]
+	for _, m := range f.queue {
+		obj := pkg.Scope().Lookup(m.name)
+		if obj == nil {
+			return false, fmt.Errorf("%s: %s is not declared", arch, m.name)
+		}
+		st := obj.Type().Underlying().(*types.Struct)
+		var vars []*types.Var
+		for i := 0; i < st.NumFields(); i++ {
+			vars = append(vars, st.Field(i))
+		}
+		offs := sizes.Offsetsof(vars)
+		got := make(map[string]int64, len(vars))
+		for i, v := range vars {
+			if v.Name() != "_" {
+				got[v.Name()] = offs[i]
+			}
+		}
+
+		for i, fd := range m.fields {
+			want, _ := h.offset(m.rt, fd.rt)
+			have := got[fd.name]
+			switch {
+			case have < want:
+				fd.pad[arch] += want - have
+				return true, nil
+			case have > want:
+				if fd.pad[arch] > 0 {
+					fd.pad[arch] -= min(fd.pad[arch], have-want)
+					return true, nil
+				}
+				if i == 0 {
+					return false, fmt.Errorf("%s: %s.%s at %d, runtime has it at %d", arch, m.name, fd.name, have, want)
+				}
+				prev := m.fields[i-1]
+				if prev.opaque {
+					return false, fmt.Errorf("%s: %s.%s at %d, runtime has it at %d", arch, m.name, fd.name, have, want)
+				}
+				prev.opaque = true
+				return true, nil
+			}
+		}
+
+		if m.prefix {
+			continue
+		}
+		want, _ := h.size(m.rt)
+		have := sizes.Sizeof(st)
+		pad := &m.pad
+		if arch == "386" {
+			pad = &m.pad32
+		}
+		switch {
+		case have < want:
+			*pad += want - have
+			return true, nil
+		case have > want:
+			if *pad > 0 {
+				*pad -= min(*pad, have-want)
+				return true, nil
+			}
+			if n := len(m.fields); n > 0 && !m.fields[n-1].opaque {
+				m.fields[n-1].opaque = true
+				return true, nil
+			}
+			return false, fmt.Errorf("%s: %s is %d bytes, runtime.%s is %d", arch, m.name, have, m.rt, want)
+		}
+	}
+	return false, nil
+}
+
+// check type checks package g in dir for arch with src as the layout
+// file of version.
+func check(dir, arch string, version int, src []byte) (*types.Package, types.Sizes, error) {
+	ctx := build.Default
+	ctx.GOOS = "linux"
+	ctx.GOARCH = arch
+	ctx.CgoEnabled = false
+	ctx.ReleaseTags = nil
+	for v := 1; v <= version; v++ {
+		ctx.ReleaseTags = append(ctx.ReleaseTags, fmt.Sprintf("go1.%d", v))
+	}
+
+	self := fmt.Sprintf("runtime2_go1%d.go", version)
+	fset := token.NewFileSet()
+	var files []*ast.File
+	ents, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, e := range ents {
+		name := e.Name()
+		if e.IsDir() || name == self || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		if ok, err := ctx.MatchFile(dir, name); err != nil || !ok {
+			continue
+		}
+		af, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, af)
+	}
+	af, err := parser.ParseFile(fset, self, src, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	files = append(files, af)
+
+	sizes := types.SizesFor("gc", arch)
+	var errs []string
+	conf := types.Config{
+		Importer: importer.Default(),
+		Sizes:    sizes,
+		Error:    func(err error) { errs = append(errs, err.Error()) },
+	}
+	pkg, _ := conf.Check("github.com/sitano/gsysint/g", fset, files, nil)
+	if len(errs) > 0 {
+		return nil, nil, fmt.Errorf("%s: generated layout does not type check:\n%s", arch, strings.Join(errs, "\n"))
+	}
+	return pkg, sizes, nil
+}
+
+func exprString(e ast.Expr) string {
+	return types.ExprString(e)
+}
@@ -0,0 +1,109 @@
+//go:build go1.21
+// +build go1.21
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// header is the go_asm.h of package runtime for one arch.
+type header struct {
+	arch  string
+	lines []string         // #define lines in order
+	vals  map[string]int64 // integer defines by name
+}
+
+// asmHeader builds package runtime for arch with the toolchain of goroot
+// and returns the go_asm.h its compiler wrote for it.
+func asmHeader(goroot, arch string) (header, error) {
+	cmd := goCommand(goroot, "build", "-a", "-work", "runtime")
+	cmd.Env = append(cmd.Env, "GOARCH="+arch, "GOOS=linux", "CGO_ENABLED=0")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return header{}, fmt.Errorf("go build runtime: %v\n%s", err, out)
+	}
+
+	var work string
+	for _, l := range strings.Split(string(out), "\n") {
+		if strings.HasPrefix(l, "WORK=") {
+			work = strings.TrimPrefix(l, "WORK=")
+		}
+	}
+	if work == "" {
+		return header{}, fmt.Errorf("go build runtime: no WORK directory in output:\n%s", out)
+	}
+	defer os.RemoveAll(work)
+
+	// b001 is the action of the package named on the command line.
+	data, err := os.ReadFile(filepath.Join(work, "b001", "go_asm.h"))
+	if err != nil {
+		return header{}, err
+	}
+	return parseHeader(arch, data), nil
+}
+
+func parseHeader(arch string, data []byte) header {
+	h := header{arch: arch, vals: make(map[string]int64)}
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for s.Scan() {
+		fs := strings.Fields(s.Text())
+		if len(fs) != 3 || fs[0] != "#define" {
+			continue
+		}
+		h.lines = append(h.lines, s.Text())
+		if v, err := strconv.ParseInt(fs[2], 0, 64); err == nil {
+			h.vals[fs[1]] = v
+		}
+	}
+	return h
+}
+
+// size returns the size of the runtime struct typ.
+func (h header) size(typ string) (int64, bool) {
+	v, ok := h.vals[typ+"__size"]
+	return v, ok
+}
+
+// offset returns the offset of field in the runtime struct typ.
+func (h header) offset(typ, field string) (int64, bool) {
+	v, ok := h.vals[typ+"_"+field]
+	return v, ok
+}
+
+// next returns the offset of the field of typ that follows the one at
+// off, or end if there is none.
+func (h header) next(typ string, off, end int64) int64 {
+	for n, v := range h.vals {
+		if strings.HasPrefix(n, typ+"_") && n != typ+"__size" && v > off && v < end {
+			end = v
+		}
+	}
+	return end
+}
+
+// constant returns the value of the runtime constant name.
+func (h header) constant(name string) (int64, bool) {
+	v, ok := h.vals["const_"+name]
+	return v, ok
+}
+
+// asm returns the g and m part of the header, which is all the stubs use.
+func (h header) asm(version int) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Layout of the go1.%d runtime g and m, in the format of go tool compile -asmhdr.\n\n", version)
+	for _, l := range h.lines {
+		n := strings.Fields(l)[1]
+		if strings.HasPrefix(n, "g_") || strings.HasPrefix(n, "m_") {
+			b.WriteString(l)
+			b.WriteByte('\n')
+		}
+	}
+	return b.Bytes()
+}
@@ -0,0 +1,162 @@
+//go:build go1.21
+// +build go1.21
+
+// Command gen generates the package g mirrors of the runtime structures
+// of the toolchain it is run with:
+//
+//   - runtime2_go1NN.go with G, M and the structures they embed, with
+//     exported field names, and the WaitReason table;
+//   - go_asm_go1NN_<arch>.h with the g and m offsets for the asm stubs,
+//     and the asm_go1NN_<arch>.s files including them.
+//
+// The field lists come from $GOROOT/src/runtime parsed with go/ast, the
+// offsets from the go_asm.h header the compiler emits for the runtime
+// (go tool compile -asmhdr). Fields that can not be mirrored become
+// opaque byte arrays of the right size. The result is type checked for
+// every arch and compared with the runtime offsets before it is written.
+//
+// Run it from package g with go generate. The generator itself needs
+// go1.21, so it can not regenerate the older releases with their own
+// toolchain: run it with a go1.21+ go and point -goroot at the GOROOT of
+// the release, whose go command builds the runtime for the offsets.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// archs are the architectures package g has asm stubs for.
+var archs = []string{"amd64", "386"}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen: ")
+
+	dir := flag.String("dir", ".", "package g directory")
+	goroot := flag.String("goroot", "", "GOROOT of the toolchain (default: go env GOROOT)")
+	version := flag.Int("version", 0, "go1.N minor version of the toolchain (default: go env GOVERSION of -goroot)")
+	flag.Parse()
+
+	if *goroot == "" {
+		*goroot = goEnv("", "GOROOT")
+	}
+	if *version == 0 {
+		v, err := minorVersion(goEnv(*goroot, "GOVERSION"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		*version = v
+	}
+
+	rt, err := parseRuntime(*goroot, "amd64")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	hdrs := make(map[string]header, len(archs))
+	for _, arch := range archs {
+		h, err := asmHeader(*goroot, arch)
+		if err != nil {
+			log.Fatal(err)
+		}
+		hdrs[arch] = h
+	}
+
+	f := newFile(rt, hdrs, *version)
+	if err := f.build(); err != nil {
+		log.Fatal(err)
+	}
+	if err := f.fit(*dir); err != nil {
+		log.Fatal(err)
+	}
+
+	name := fmt.Sprintf("go1%d", *version)
+	write(filepath.Join(*dir, "runtime2_"+name+".go"), f.source())
+	for _, arch := range archs {
+		write(filepath.Join(*dir, "go_asm_"+name+"_"+arch+".h"), hdrs[arch].asm(*version))
+		write(filepath.Join(*dir, "asm_"+name+"_"+arch+".s"), asmStub(*version, arch))
+	}
+	if err := bumpUnsupported(filepath.Join(*dir, "unsupported.go"), *version); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// goCommand runs the go command of goroot, with GOROOT set and no
+// switching to another toolchain.
+func goCommand(goroot string, args ...string) *exec.Cmd {
+	cmd := exec.Command(filepath.Join(goroot, "bin", "go"), args...)
+	cmd.Env = append(os.Environ(), "GOROOT="+goroot, "GOTOOLCHAIN=local")
+	return cmd
+}
+
+// goEnv returns go env name of the toolchain in goroot, or of the go
+// command in PATH if goroot is empty.
+func goEnv(goroot, name string) string {
+	cmd := exec.Command("go", "env", name)
+	if goroot != "" {
+		cmd = goCommand(goroot, "env", name)
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		log.Fatalf("go env %s: %v", name, err)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+var versionRe = regexp.MustCompile(`^go1\.(\d+)`)
+
+func minorVersion(v string) (int, error) {
+	m := versionRe.FindStringSubmatch(v)
+	if m == nil {
+		return 0, fmt.Errorf("can not tell the release of %q, use -version", v)
+	}
+	return strconv.Atoi(m[1])
+}
+
+func write(path string, data []byte) {
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func buildTags(version int) string {
+	return fmt.Sprintf("//go:build go1.%d && !go1.%d\n// +build go1.%d,!go1.%d\n", version, version+1, version, version+1)
+}
+
+func asmStub(version int, arch string) []byte {
+	return []byte(fmt.Sprintf("%s\n#include \"go_asm_go1%d_%s.h\"\n#include \"asm_%s.h\"\n", buildTags(version), version, arch, arch))
+}
+
+// unsupportedRe matches the upper bound of the releases, the lower one is
+// negated.
+var unsupportedRe = regexp.MustCompile(`(^|[^!])go1\.(\d+)`)
+
+// bumpUnsupported moves the upper bound of unsupported.go past version.
+func bumpUnsupported(path string, version int) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	lines := strings.SplitAfter(string(data), "\n")
+	for i, l := range lines {
+		if !strings.HasPrefix(l, "//go:build") && !strings.HasPrefix(l, "// +build") {
+			continue
+		}
+		lines[i] = unsupportedRe.ReplaceAllStringFunc(l, func(s string) string {
+			m := unsupportedRe.FindStringSubmatch(s)
+			if v, _ := strconv.Atoi(m[2]); v <= version {
+				return fmt.Sprintf("%sgo1.%d", m[1], version+1)
+			}
+			return s
+		})
+	}
+	return os.WriteFile(path, []byte(strings.Join(lines, "")), 0o644)
+}
@@ -0,0 +1,580 @@
+//go:build go1.21
+// +build go1.21
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+)
+
+// roots are the runtime structs mirrored by every runtime2_go1NN.go.
+// Structs they hold by value are mirrored as well.
+var roots = []string{"gobuf", "sudog", "hchan", "timer", "_defer", "_panic", "g", "m", "p", "schedt", "stkframe", "_func"}
+
+// prefixes are the roots mirrored only up to and including the given
+// field. The rest of the runtime struct is left out of the mirror, which
+// is then only good to be pointed to.
+var prefixes = map[string]string{
+	"p":      "runnext",
+	"schedt": "stopwait",
+}
+
+// unexported are the mirrors that keep the runtime field names.
+var unexported = map[string]bool{
+	"gobuf":    true,
+	"sudog":    true,
+	"hchan":    true,
+	"_defer":   true,
+	"_panic":   true,
+	"stkframe": true,
+	"_func":    true,
+}
+
+// typeNames are the mirror names that differ from the exported runtime name.
+var typeNames = map[string]string{
+	"gobuf":        "GoBuf",
+	"hchan":        "HChan",
+	"pcvalueCache": "PcValueCache",
+	"mLockProfile": "LockProfile",
+	"sysmontick":   "SysmonTick",
+	"schedt":       "SchedT",
+	"stkframe":     "StkFrame",
+	"_func":        "Func",
+}
+
+// fieldNames keeps the mirror field names of package g that differ from
+// the exported runtime name, so the mirrors stay source compatible
+// between releases.
+var fieldNames = map[string]string{
+	"g.stackguard0":         "StackGuard0",
+	"g.stackguard1":         "StackGuard1",
+	"g.syscallsp":           "SysCallSP",
+	"g.syscallpc":           "SysCallPC",
+	"g.syscallbp":           "SysCallBP",
+	"g.stktopsp":            "StkTopSP",
+	"g.atomicstatus":        "AtomicStatus",
+	"g.goid":                "GoID",
+	"g.schedlink":           "SchedLink",
+	"g.waitsince":           "WaitSince",
+	"g.waitreason":          "WaitReason",
+	"g.paniconfault":        "PanicOnFault",
+	"g.gcscandone":          "GcScanDone",
+	"g.throwsplit":          "ThrowSplit",
+	"g.coroexit":            "CoroExit",
+	"g.raceignore":          "RaceIgnore",
+	"g.nocgocallback":       "NoCgoCallback",
+	"g.lockedm":             "LockedM",
+	"g.writebuf":            "WriteBuf",
+	"g.sigcode0":            "SigCode0",
+	"g.sigcode1":            "SigCode1",
+	"g.sigpc":               "SigPC",
+	"g.parentGoid":          "ParentGoID",
+	"g.gopc":                "GoPC",
+	"g.startpc":             "StartPC",
+	"g.racectx":             "RaceCtx",
+	"g.cgoCtxt":             "CgoCtxt",
+	"g.coroarg":             "CoroArg",
+	"g.valgrindStackID":     "ValgrindStackID",
+	"m.morebuf":             "MoreBuf",
+	"m.divmod":              "DivMod",
+	"m.procid":              "ProcID",
+	"m.gsignal":             "GSignal",
+	"m.sigmask":             "SigMask",
+	"m.tls":                 "TLS",
+	"m.mstartfn":            "MStartFn",
+	"m.curg":                "CurG",
+	"m.caughtsig":           "CaughtSig",
+	"m.nextp":               "NextP",
+	"m.oldp":                "OldP",
+	"m.id":                  "ID",
+	"m.mallocing":           "MAllocing",
+	"m.preemptoff":          "PreemptOff",
+	"m.profilehz":           "ProfileHz",
+	"m.newSigstack":         "NewSigStack",
+	"m.printlock":           "PrintLock",
+	"m.incgo":               "IncGo",
+	"m.isextra":             "IsExtra",
+	"m.needextram":          "NeedextRam",
+	"m.traceback":           "TraceBack",
+	"m.allpSnapshot":        "AllPSnapshot",
+	"m.ncgocall":            "NCgoCall",
+	"m.ncgo":                "NCgo",
+	"m.alllink":             "AllLink",
+	"m.schedlink":           "SchedLink",
+	"m.lockedg":             "LockedG",
+	"m.createstack":         "CreateStack",
+	"m.waitunlockf":         "WaitUnlockF",
+	"m.waitlock":            "WaitLock",
+	"m.syscalltick":         "SysCallTick",
+	"m.freelink":            "FreeLink",
+	"m.libcallpc":           "LibCallPC",
+	"m.libcallsp":           "LibCallSP",
+	"m.libcallg":            "LibCallG",
+	"m.winsyscall":          "WinSysCall",
+	"m.vdsoSP":              "VDSOSP",
+	"m.vdsoPC":              "VDSOPC",
+	"m.pcvalueCache":        "PcValueCache",
+	"m.mOS":                 "MOS",
+	"m.cheaprand":           "CheapRand",
+	"m.cheaprand64":         "CheapRand64",
+	"m.self":                "Self",
+	"timer.astate":          "AState",
+	"timer.ts":              "TS",
+	"pcvalueCache.inUse":    "InUse",
+	"heldLockInfo.rank":     "Rank",
+	"heldLockInfo.lockAddr": "LockAddr",
+
+	"p.id":                   "ID",
+	"p.schedtick":            "SchedTick",
+	"p.syscalltick":          "SysCallTick",
+	"p.sysmontick":           "SysmonTick",
+	"p.mcache":               "MCache",
+	"p.pcache":               "PCache",
+	"p.raceprocctx":          "RaceProcCtx",
+	"p.oldm":                 "OldM",
+	"p.deferpool":            "DeferPool",
+	"p.deferpoolbuf":         "DeferPoolBuf",
+	"p.goidcache":            "GoIDCache",
+	"p.goidcacheend":         "GoIDCacheEnd",
+	"p.runqhead":             "RunqHead",
+	"p.runqtail":             "RunqTail",
+	"p.runnext":              "RunNext",
+	"sysmontick.schedtick":   "SchedTick",
+	"sysmontick.syscalltick": "SysCallTick",
+	"sysmontick.schedwhen":   "SchedWhen",
+	"sysmontick.syscallwhen": "SysCallWhen",
+
+	"schedt.goidgen":      "GoIDGen",
+	"schedt.lastpoll":     "LastPoll",
+	"schedt.midle":        "MIdle",
+	"schedt.nmidle":       "NMIdle",
+	"schedt.nmidlelocked": "NMIdleLocked",
+	"schedt.mnext":        "MNext",
+	"schedt.maxmcount":    "MaxMCount",
+	"schedt.nmsys":        "NMSys",
+	"schedt.nmfreed":      "NMFreed",
+	"schedt.ngsys":        "NGSys",
+	"schedt.nGsyscallNoP": "NGSyscallNoP",
+	"schedt.pidle":        "PIdle",
+	"schedt.npidle":       "NPIdle",
+	"schedt.nmspinning":   "NMSpinning",
+	"schedt.needspinning": "NeedSpinning",
+	"schedt.runqsize":     "RunqSize",
+	"schedt.sudoglock":    "SudogLock",
+	"schedt.sudogcache":   "SudogCache",
+	"schedt.deferlock":    "DeferLock",
+	"schedt.deferpool":    "DeferPool",
+	"schedt.freem":        "FreeM",
+	"schedt.gcwaiting":    "GCWaiting",
+	"schedt.stopwait":     "StopWait",
+}
+
+// fieldTypes are the mirror field types that differ from the translated
+// runtime type, for the fields package g gives a named type.
+var fieldTypes = map[string]string{
+	"p.status": "PStatus",
+}
+
+// known are the runtime types that have a hand written counterpart in
+// package g, shared by all releases.
+var known = map[string]string{
+	"mutex":        "Mutex",
+	"note":         "Note",
+	"stack":        "Stack",
+	"funcval":      "FuncVal",
+	"guintptr":     "Guintptr",
+	"muintptr":     "Muintptr",
+	"puintptr":     "Puintptr",
+	"waitReason":   "WaitReason",
+	"waitq":        "WaitQ",
+	"ancestorInfo": "AncestorInfo",
+	"libcall":      "LibCall",
+	"winlibcall":   "WinLibCall",
+	"sigset":       "SigSet",
+	"gsignalStack": "GSignalStack",
+	"cgoCallers":   "CgoCallers",
+	"xRegPerG":     "XRegPerG",
+	"_type":        "Type",
+	"mcache":       "MCache",
+	"bitvector":    "BitVector",
+}
+
+// pointerOnly are the known types whose package g counterpart does not
+// have the runtime layout, they can only be pointed to.
+var pointerOnly = map[string]bool{
+	"_type":     true,
+	"mcache":    true,
+	"bitvector": true,
+}
+
+var basic = map[string]bool{
+	"bool": true, "string": true, "error": true,
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true, "uintptr": true,
+	"byte": true, "rune": true,
+	"float32": true, "float64": true, "complex64": true, "complex128": true,
+}
+
+// atomics maps the internal/runtime/atomic types to their plain layout.
+// The 64-bit ones keep sync/atomic types for their 8 byte alignment on
+// 32-bit platforms.
+var atomics = map[string]string{
+	"Int32":         "int32",
+	"Uint8":         "uint8",
+	"Uint32":        "uint32",
+	"Bool":          "bool",
+	"Uintptr":       "uintptr",
+	"UnsafePointer": "unsafe.Pointer",
+	"Pointer":       "unsafe.Pointer",
+	"Int64":         "atomic.Int64",
+	"Uint64":        "atomic.Uint64",
+	"Float64":       "atomic.Uint64",
+}
+
+// mirror is a generated struct.
+type mirror struct {
+	rt     string // runtime type name
+	name   string // mirror type name
+	fields []*field
+	pad    int64 // trailing padding on amd64, pad32 on 386
+	pad32  int64
+	prefix bool // only the leading fields of the runtime struct
+}
+
+// field is a generated struct field. An opaque field has no type and is
+// emitted as a byte array of the runtime size.
+type field struct {
+	rt       string // runtime field name
+	name     string
+	typ      string
+	embedded bool
+	doc      []string
+	gap      bool // blank line before the field in the runtime
+	comment  string
+	rtType   string // runtime type expression, for opaque fields
+
+	opaque bool
+	size   map[string]int64 // opaque size by arch
+	pad    map[string]int64 // padding before the field by arch
+}
+
+func exportName(name string) string {
+	name = strings.TrimLeft(name, "_")
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func (f *file) mirrorName(rt string) string {
+	if n, ok := typeNames[rt]; ok {
+		return n
+	}
+	return exportName(rt)
+}
+
+// need returns the mirror name of the runtime struct rt, scheduling it.
+func (f *file) need(rt string) string {
+	if _, ok := f.byName[rt]; !ok {
+		m := &mirror{rt: rt, name: f.mirrorName(rt)}
+		f.byName[rt] = m
+		f.queue = append(f.queue, m)
+	}
+	return f.byName[rt].name
+}
+
+// typ translates a runtime type expression into the mirror type, ok is
+// false if it can not be mirrored.
+func (f *file) typ(e ast.Expr) (string, bool) {
+	switch e := e.(type) {
+	case *ast.Ident:
+		if e.Name == "any" {
+			return "interface{}", true
+		}
+		if basic[e.Name] {
+			return e.Name, true
+		}
+		if n, ok := known[e.Name]; ok {
+			return n, !pointerOnly[e.Name]
+		}
+		ts, ok := f.rt.types[e.Name]
+		if !ok || ts.TypeParams != nil {
+			return "", false
+		}
+		if ts.Assign != 0 {
+			return f.typ(ts.Type)
+		}
+		if _, ok := ts.Type.(*ast.StructType); ok {
+			return f.need(e.Name), true
+		}
+		return f.typ(ts.Type)
+
+	case *ast.SelectorExpr:
+		pkg, ok := e.X.(*ast.Ident)
+		if !ok {
+			return "", false
+		}
+		switch pkg.Name {
+		case "unsafe":
+			return "unsafe.Pointer", e.Sel.Name == "Pointer"
+		case "atomic":
+			t, ok := atomics[e.Sel.Name]
+			return t, ok
+		}
+		return "", false
+
+	case *ast.IndexExpr:
+		// atomic.Pointer[T]
+		if sel, ok := e.X.(*ast.SelectorExpr); ok {
+			if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "atomic" && sel.Sel.Name == "Pointer" {
+				return "unsafe.Pointer", true
+			}
+		}
+		return "", false
+
+	case *ast.StarExpr:
+		return f.pointer(e.X), true
+
+	case *ast.ArrayType:
+		if e.Len == nil {
+			elem, ok := f.elem(e.Elt)
+			return "[]" + elem, ok
+		}
+		n, ok := f.length(e.Len)
+		if !ok {
+			return "", false
+		}
+		elem, ok := f.typ(e.Elt)
+		return fmt.Sprintf("[%d]%s", n, elem), ok
+
+	case *ast.FuncType:
+		return f.funcType(e), true
+
+	case *ast.InterfaceType:
+		if e.Methods == nil || len(e.Methods.List) == 0 {
+			return "interface{}", true
+		}
+		return "", false
+
+	case *ast.MapType, *ast.ChanType:
+		return "unsafe.Pointer", true
+
+	case *ast.StructType:
+		var b strings.Builder
+		b.WriteString("struct {\n")
+		for _, fl := range e.Fields.List {
+			t, ok := f.typ(fl.Type)
+			if !ok {
+				return "", false
+			}
+			if len(fl.Names) == 0 {
+				return "", false
+			}
+			for _, n := range fl.Names {
+				fmt.Fprintf(&b, "%s %s\n", exportName(n.Name), t)
+			}
+		}
+		b.WriteString("}")
+		return b.String(), true
+	}
+	return "", false
+}
+
+// pointer translates *e: a typed pointer to the mirrors and known types,
+// unsafe.Pointer to anything else.
+func (f *file) pointer(e ast.Expr) string {
+	if id, ok := e.(*ast.Ident); ok {
+		if basic[id.Name] {
+			return "*" + id.Name
+		}
+		if n, ok := known[id.Name]; ok {
+			return "*" + n
+		}
+		if m, ok := f.byName[id.Name]; ok {
+			return "*" + m.name
+		}
+		for _, r := range roots {
+			if r == id.Name {
+				return "*" + f.need(id.Name)
+			}
+		}
+		return "unsafe.Pointer"
+	}
+	if t, ok := f.typ(e); ok && !strings.HasPrefix(t, "struct") {
+		return "*" + t
+	}
+	return "unsafe.Pointer"
+}
+
+// elem translates the element type of a slice, which does not need
+// the runtime layout.
+func (f *file) elem(e ast.Expr) (string, bool) {
+	if st, ok := e.(*ast.StarExpr); ok {
+		return f.pointer(st.X), true
+	}
+	if id, ok := e.(*ast.Ident); ok {
+		if _, ok := f.rt.structType(id.Name); ok {
+			if n, ok := known[id.Name]; ok && !pointerOnly[id.Name] {
+				return n, true
+			}
+			return "", false
+		}
+	}
+	return f.typ(e)
+}
+
+func (f *file) funcType(e *ast.FuncType) string {
+	list := func(fl *ast.FieldList) (string, bool) {
+		if fl == nil {
+			return "", true
+		}
+		var ts []string
+		for _, p := range fl.List {
+			t, ok := f.elem(p.Type)
+			if !ok {
+				return "", false
+			}
+			n := len(p.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				ts = append(ts, t)
+			}
+		}
+		return strings.Join(ts, ", "), true
+	}
+	params, ok1 := list(e.Params)
+	results, ok2 := list(e.Results)
+	if !ok1 || !ok2 {
+		return "unsafe.Pointer"
+	}
+	s := "func(" + params + ")"
+	switch {
+	case e.Results == nil || len(e.Results.List) == 0:
+	case strings.Contains(results, ",") || len(e.Results.List[0].Names) > 0:
+		s += " (" + results + ")"
+	default:
+		s += " " + results
+	}
+	return s
+}
+
+// length evaluates an array length: a literal or a runtime constant that
+// is the same for all archs.
+func (f *file) length(e ast.Expr) (int64, bool) {
+	switch e := e.(type) {
+	case *ast.BasicLit:
+		var n int64
+		_, err := fmt.Sscan(e.Value, &n)
+		return n, err == nil
+	case *ast.Ident:
+		var n int64 = -1
+		for _, arch := range archs {
+			v, ok := f.hdrs[arch].constant(e.Name)
+			if !ok || (n >= 0 && v != n) {
+				return 0, false
+			}
+			n = v
+		}
+		return n, true
+	}
+	return 0, false
+}
+
+// fields fills m with the fields of its runtime struct.
+func (f *file) fields(m *mirror) error {
+	st, ok := f.rt.structType(m.rt)
+	if !ok {
+		return fmt.Errorf("runtime.%s is not a struct", m.rt)
+	}
+	prev := st.Fields.Opening
+	for _, fl := range st.Fields.List {
+		gap := f.rt.fset.Position(fl.Pos()).Line-f.rt.fset.Position(prev).Line > 1
+		if fl.Doc != nil {
+			gap = f.rt.fset.Position(fl.Doc.Pos()).Line-f.rt.fset.Position(prev).Line > 1
+		}
+		prev = fl.End()
+		names := fl.Names
+		embedded := len(names) == 0
+		if embedded {
+			names = []*ast.Ident{{Name: embeddedName(fl.Type)}}
+		}
+		for _, n := range names {
+			if n.Name == "_" || n.Name == "" {
+				continue
+			}
+			if _, ok := f.hdrs[archs[0]].offset(m.rt, n.Name); !ok {
+				// Zero sized fields like sys.NotInHeap.
+				continue
+			}
+			fd := &field{
+				rt:       n.Name,
+				embedded: embedded,
+				doc:      docLines(fl.Doc),
+				gap:      gap && len(m.fields) > 0,
+				rtType:   exprString(fl.Type),
+				comment:  lineComment(fl),
+			}
+			fd.typ, ok = f.typ(fl.Type)
+			if t, named := fieldTypes[m.rt+"."+n.Name]; named {
+				fd.typ, ok = t, true
+			}
+			fd.opaque = !ok
+			fd.name = f.fieldName(m, n.Name, embedded, fd.typ)
+			m.fields = append(m.fields, fd)
+			if prefixes[m.rt] == n.Name {
+				m.prefix = true
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+func (f *file) fieldName(m *mirror, name string, embedded bool, typ string) string {
+	if embedded && typ != "" && !strings.ContainsAny(typ, "[]*. ") {
+		return typ
+	}
+	if unexported[m.rt] {
+		return name
+	}
+	if n, ok := fieldNames[m.rt+"."+name]; ok {
+		return n
+	}
+	return exportName(name)
+}
+
+func embeddedName(e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.StarExpr:
+		return embeddedName(e.X)
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	case *ast.IndexExpr:
+		return embeddedName(e.X)
+	}
+	return ""
+}
+
+func lineComment(fl *ast.Field) string {
+	if fl.Comment == nil || len(fl.Comment.List) != 1 {
+		return ""
+	}
+	return fl.Comment.List[0].Text
+}
+
+func docLines(cg *ast.CommentGroup) []string {
+	if cg == nil {
+		return nil
+	}
+	var ls []string
+	for _, c := range cg.List {
+		ls = append(ls, c.Text)
+	}
+	return ls
+}
@@ -0,0 +1,143 @@
+//go:build go1.21
+// +build go1.21
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// runtimeSrc is the parsed package runtime of one GOOS/GOARCH.
+type runtimeSrc struct {
+	fset  *token.FileSet
+	types map[string]*ast.TypeSpec
+
+	// waitReasons are the waitReason constants in order.
+	waitReasons []string
+	// waitReasonStrings maps the waitReason constants to their text.
+	waitReasonStrings map[string]string
+}
+
+func parseRuntime(goroot, arch string) (*runtimeSrc, error) {
+	ctx := build.Default
+	ctx.GOROOT = goroot
+	ctx.GOOS = "linux"
+	ctx.GOARCH = arch
+	ctx.CgoEnabled = false
+
+	dir := filepath.Join(goroot, "src", "runtime")
+	ents, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	rt := &runtimeSrc{
+		types:             make(map[string]*ast.TypeSpec),
+		waitReasonStrings: make(map[string]string),
+	}
+	fset := token.NewFileSet()
+	rt.fset = fset
+	for _, e := range ents {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		if ok, err := ctx.MatchFile(dir, name); err != nil || !ok {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		rt.collect(f)
+	}
+
+	if len(rt.waitReasons) == 0 {
+		return nil, fmt.Errorf("no waitReason constants in %s", dir)
+	}
+	return rt, nil
+}
+
+func (rt *runtimeSrc) collect(f *ast.File) {
+	for _, d := range f.Decls {
+		gd, ok := d.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		switch gd.Tok {
+		case token.TYPE:
+			for _, s := range gd.Specs {
+				ts := s.(*ast.TypeSpec)
+				if _, dup := rt.types[ts.Name.Name]; !dup {
+					rt.types[ts.Name.Name] = ts
+				}
+			}
+		case token.CONST:
+			rt.collectWaitReasons(gd)
+		case token.VAR:
+			rt.collectWaitReasonStrings(gd)
+		}
+	}
+}
+
+func (rt *runtimeSrc) collectWaitReasons(gd *ast.GenDecl) {
+	if len(gd.Specs) == 0 {
+		return
+	}
+	first := gd.Specs[0].(*ast.ValueSpec)
+	if id, ok := first.Type.(*ast.Ident); !ok || id.Name != "waitReason" {
+		return
+	}
+	for _, s := range gd.Specs {
+		for _, n := range s.(*ast.ValueSpec).Names {
+			if n.Name != "_" {
+				rt.waitReasons = append(rt.waitReasons, n.Name)
+			}
+		}
+	}
+}
+
+func (rt *runtimeSrc) collectWaitReasonStrings(gd *ast.GenDecl) {
+	for _, s := range gd.Specs {
+		vs := s.(*ast.ValueSpec)
+		if len(vs.Names) != 1 || vs.Names[0].Name != "waitReasonStrings" || len(vs.Values) != 1 {
+			continue
+		}
+		cl, ok := vs.Values[0].(*ast.CompositeLit)
+		if !ok {
+			continue
+		}
+		for _, e := range cl.Elts {
+			kv, ok := e.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			k, ok1 := kv.Key.(*ast.Ident)
+			v, ok2 := kv.Value.(*ast.BasicLit)
+			if !ok1 || !ok2 || v.Kind != token.STRING {
+				continue
+			}
+			if s, err := strconv.Unquote(v.Value); err == nil {
+				rt.waitReasonStrings[k.Name] = s
+			}
+		}
+	}
+}
+
+// structType returns the struct type declared as name.
+func (rt *runtimeSrc) structType(name string) (*ast.StructType, bool) {
+	ts, ok := rt.types[name]
+	if !ok || ts.TypeParams != nil || ts.Assign != 0 {
+		return nil, false
+	}
+	st, ok := ts.Type.(*ast.StructType)
+	return st, ok
+}
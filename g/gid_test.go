@@ -0,0 +1,67 @@
+package g_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/sitano/gsysint"
+	"github.com/sitano/gsysint/g"
+)
+
+func TestGoID(t *testing.T) {
+	if !g.Verified() {
+		t.Log("layouts not verified, GoID falls back to the stack trace:", g.VerifyError())
+	}
+	testGoIDs(t)
+}
+
+func TestGoIDFallback(t *testing.T) {
+	defer func() {
+		g.SetAsmOffsets(g.AsmLayout)
+		if err := g.Verify(); err != nil {
+			t.Fatal("restore:", err)
+		}
+	}()
+	g.SetAsmOffsets(func() (gSize, gM, gGoID, gAtomicStatus, gLockedM, mSize, mG0, mCurG, mLockedG uintptr) {
+		gSize, gM, gGoID, gAtomicStatus, gLockedM, mSize, mG0, mCurG, mLockedG = g.AsmLayout()
+		return gSize, gM, gGoID + 8, gAtomicStatus, gLockedM, mSize, mG0, mCurG, mLockedG
+	})
+	if err := g.Verify(); err == nil || g.CurG() != nil {
+		t.Fatal("the layouts verified with a wrong goid offset")
+	}
+	testGoIDs(t)
+}
+
+// testGoIDs checks gsysint.GoID against gsysint.GIDFromStackTrace across
+// goroutines.
+func testGoIDs(t *testing.T) {
+	const n = 1000
+	ids := make(chan uint64, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id := gsysint.GoID()
+			sid, err := gsysint.GIDFromStackTrace()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if id != sid {
+				t.Errorf("GoID() = %d, stack trace says %d", id, sid)
+			}
+			ids <- id
+		}()
+	}
+	wg.Wait()
+	close(ids)
+
+	seen := make(map[uint64]bool, n)
+	for id := range ids {
+		if id == 0 || seen[id] {
+			t.Fatalf("goroutine id %d is zero or not unique", id)
+		}
+		seen[id] = true
+	}
+}
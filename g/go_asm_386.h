@@ -1,1363 +0,0 @@
-// generated by 8g -asmhdr from package runtime
-
-#define const_c0 0xaa7f8ea9
-#define const_c1 0xc2ba76cd
-#define const_alg_MEM 0x0
-#define const_alg_MEM0 0x1
-#define const_alg_MEM8 0x2
-#define const_alg_MEM16 0x3
-#define const_alg_MEM32 0x4
-#define const_alg_MEM64 0x5
-#define const_alg_MEM128 0x6
-#define const_alg_NOEQ 0x7
-#define const_alg_NOEQ0 0x8
-#define const_alg_NOEQ8 0x9
-#define const_alg_NOEQ16 0xa
-#define const_alg_NOEQ32 0xb
-#define const_alg_NOEQ64 0xc
-#define const_alg_NOEQ128 0xd
-#define const_alg_STRING 0xe
-#define const_alg_INTER 0xf
-#define const_alg_NILINTER 0x10
-#define const_alg_SLICE 0x11
-#define const_alg_FLOAT32 0x12
-#define const_alg_FLOAT64 0x13
-#define const_alg_CPLX64 0x14
-#define const_alg_CPLX128 0x15
-#define const_alg_max 0x16
-#define typeAlg__size 8
-#define typeAlg_hash 0
-#define typeAlg_equal 4
-#define const_hashRandomBytes 0x40
-#define const_cgoCheckPointerFail "cgo argument has Go pointer to Go pointer"
-#define const_cgoResultFail "cgo result has Go pointer"
-#define const_cgoWriteBarrierFail "Go pointer stored into non-Go memory"
-#define const_maxAlign 0x8
-#define const_hchanSize 0x38
-#define const_debugChan false
-#define hchan__size 52
-#define hchan_qcount 0
-#define hchan_dataqsiz 4
-#define hchan_buf 8
-#define hchan_elemsize 12
-#define hchan_closed 16
-#define hchan_elemtype 20
-#define hchan_sendx 24
-#define hchan_recvx 28
-#define hchan_recvq 32
-#define hchan_sendq 40
-#define hchan_lock 48
-#define waitq__size 8
-#define waitq_first 0
-#define waitq_last 4
-#define const_Compiler "gc"
-#define const_numBuckets 0x400
-#define const_logSize 0x20000
-#define const_assoc 0x4
-#define const_maxCPUProfStack 0x40
-#define cpuprofEntry__size 264
-#define cpuprofEntry_count 0
-#define cpuprofEntry_depth 4
-#define cpuprofEntry_stack 8
-#define cpuProfile__size 1605672
-#define cpuProfile_on 0
-#define cpuProfile_wait 4
-#define cpuProfile_count 8
-#define cpuProfile_evicts 12
-#define cpuProfile_lost 16
-#define cpuProfile_hash 20
-#define cpuProfile_log 1081364
-#define cpuProfile_nlog 1605652
-#define cpuProfile_toggle 1605656
-#define cpuProfile_handoff 1605660
-#define cpuProfile_wtoggle 1605664
-#define cpuProfile_wholding 1605668
-#define cpuProfile_flushing 1605669
-#define cpuProfile_eodSent 1605670
-#define const__PROT_NONE 0x0
-#define const__PROT_READ 0x1
-#define const__PROT_WRITE 0x2
-#define const__PROT_EXEC 0x4
-#define const__MAP_ANON 0x1
-#define const__MAP_PRIVATE 0x2
-#define const__DUPLICATE_SAME_ACCESS 0x2
-#define const__THREAD_PRIORITY_HIGHEST 0x2
-#define const__SIGINT 0x2
-#define const__CTRL_C_EVENT 0x0
-#define const__CTRL_BREAK_EVENT 0x1
-#define const__CONTEXT_CONTROL 0x10001
-#define const__CONTEXT_FULL 0x10007
-#define const__EXCEPTION_ACCESS_VIOLATION 0xc0000005
-#define const__EXCEPTION_BREAKPOINT 0x80000003
-#define const__EXCEPTION_FLT_DENORMAL_OPERAND 0xc000008d
-#define const__EXCEPTION_FLT_DIVIDE_BY_ZERO 0xc000008e
-#define const__EXCEPTION_FLT_INEXACT_RESULT 0xc000008f
-#define const__EXCEPTION_FLT_OVERFLOW 0xc0000091
-#define const__EXCEPTION_FLT_UNDERFLOW 0xc0000093
-#define const__EXCEPTION_INT_DIVIDE_BY_ZERO 0xc0000094
-#define const__EXCEPTION_INT_OVERFLOW 0xc0000095
-#define const__INFINITE 0xffffffff
-#define const__WAIT_TIMEOUT 0x102
-#define const__EXCEPTION_CONTINUE_EXECUTION -0x1
-#define const__EXCEPTION_CONTINUE_SEARCH 0x0
-#define systeminfo__size 36
-#define systeminfo_anon0 0
-#define systeminfo_dwpagesize 4
-#define systeminfo_lpminimumapplicationaddress 8
-#define systeminfo_lpmaximumapplicationaddress 12
-#define systeminfo_dwactiveprocessormask 16
-#define systeminfo_dwnumberofprocessors 20
-#define systeminfo_dwprocessortype 24
-#define systeminfo_dwallocationgranularity 28
-#define systeminfo_wprocessorlevel 32
-#define systeminfo_wprocessorrevision 34
-#define exceptionrecord__size 80
-#define exceptionrecord_exceptioncode 0
-#define exceptionrecord_exceptionflags 4
-#define exceptionrecord_exceptionrecord 8
-#define exceptionrecord_exceptionaddress 12
-#define exceptionrecord_numberparameters 16
-#define exceptionrecord_exceptioninformation 20
-#define floatingsavearea__size 112
-#define floatingsavearea_controlword 0
-#define floatingsavearea_statusword 4
-#define floatingsavearea_tagword 8
-#define floatingsavearea_erroroffset 12
-#define floatingsavearea_errorselector 16
-#define floatingsavearea_dataoffset 20
-#define floatingsavearea_dataselector 24
-#define floatingsavearea_registerarea 28
-#define floatingsavearea_cr0npxstate 108
-#define context__size 716
-#define context_contextflags 0
-#define context_dr0 4
-#define context_dr1 8
-#define context_dr2 12
-#define context_dr3 16
-#define context_dr6 20
-#define context_dr7 24
-#define context_floatsave 28
-#define context_seggs 140
-#define context_segfs 144
-#define context_seges 148
-#define context_segds 152
-#define context_edi 156
-#define context_esi 160
-#define context_ebx 164
-#define context_edx 168
-#define context_ecx 172
-#define context_eax 176
-#define context_ebp 180
-#define context_eip 184
-#define context_segcs 188
-#define context_eflags 192
-#define context_esp 196
-#define context_segss 200
-#define context_extendedregisters 204
-#define overlapped__size 20
-#define overlapped_internal 0
-#define overlapped_internalhigh 4
-#define overlapped_anon0 8
-#define overlapped_hevent 16
-#define TypeAssertionError__size 32
-#define TypeAssertionError_interfaceString 0
-#define TypeAssertionError_concreteString 8
-#define TypeAssertionError_assertedString 16
-#define TypeAssertionError_missingMethod 24
-#define const_GOOS "windows"
-#define const_GOARCH "386"
-#define const_fastlogNumBits 0x5
-#define const_m1 0xbce2d621
-#define const_m2 0xc70f85e1
-#define const_m3 0x319bca41
-#define const_m4 0x8b421a21
-#define const_bucketCntBits 0x3
-#define const_bucketCnt 0x8
-#define const_loadFactor 10893843943078360143403895311042249978576984729231319619400393673023933274434756918651522867260609034998150884776519916318675029784706588081477339817443328p-509
-#define const_maxKeySize 0x80
-#define const_maxValueSize 0x80
-#define const_dataOffset 0x8
-#define const_empty 0x0
-#define const_evacuatedEmpty 0x1
-#define const_evacuatedX 0x2
-#define const_evacuatedY 0x3
-#define const_minTopHash 0x4
-#define const_iterator 0x1
-#define const_oldIterator 0x2
-#define const_hashWriting 0x4
-#define const_noCheck 0xffffffff
-#define hmap__size 28
-#define hmap_count 0
-#define hmap_flags 4
-#define hmap_B 5
-#define hmap_hash0 8
-#define hmap_buckets 12
-#define hmap_oldbuckets 16
-#define hmap_nevacuate 20
-#define hmap_overflow 24
-#define bmap__size 8
-#define bmap_tophash 0
-#define hiter__size 48
-#define hiter_key 0
-#define hiter_value 4
-#define hiter_t 8
-#define hiter_h 12
-#define hiter_buckets 16
-#define hiter_bptr 20
-#define hiter_overflow 24
-#define hiter_startBucket 32
-#define hiter_offset 36
-#define hiter_wrapped 37
-#define hiter_B 38
-#define hiter_i 39
-#define hiter_bucket 40
-#define hiter_checkBucket 44
-#define const_initialZeroSize 0x400
-#define const_fieldKindEol 0x0
-#define const_fieldKindPtr 0x1
-#define const_fieldKindIface 0x2
-#define const_fieldKindEface 0x3
-#define const_tagEOF 0x0
-#define const_tagObject 0x1
-#define const_tagOtherRoot 0x2
-#define const_tagType 0x3
-#define const_tagGoroutine 0x4
-#define const_tagStackFrame 0x5
-#define const_tagParams 0x6
-#define const_tagFinalizer 0x7
-#define const_tagItab 0x8
-#define const_tagOSThread 0x9
-#define const_tagMemStats 0xa
-#define const_tagQueuedFinalizer 0xb
-#define const_tagData 0xc
-#define const_tagBSS 0xd
-#define const_tagDefer 0xe
-#define const_tagPanic 0xf
-#define const_tagMemProf 0x10
-#define const_tagAllocSample 0x11
-#define const_bufSize 0x1000
-#define const_typeCacheBuckets 0x100
-#define const_typeCacheAssoc 0x4
-#define typeCacheBucket__size 16
-#define typeCacheBucket_t 0
-#define childInfo__size 24
-#define childInfo_argoff 0
-#define childInfo_arglen 4
-#define childInfo_args 8
-#define childInfo_sp 16
-#define childInfo_depth 20
-#define const_hashSize 0x3f1
-#define const_locked 0x1
-#define const_active_spin 0x4
-#define const_active_spin_cnt 0x1e
-#define const_passive_spin 0x1
-#define const_debugMalloc false
-#define const_flagNoScan 0x1
-#define const_flagNoZero 0x2
-#define const_maxTinySize 0x10
-#define const_tinySizeClass 0x2
-#define const_maxSmallSize 0x8000
-#define const_pageShift 0xd
-#define const_pageSize 0x2000
-#define const_pageMask 0x1fff
-#define const_mSpanInUse 0x0
-#define const_concurrentSweep true
-#define const__PageShift 0xd
-#define const__PageSize 0x2000
-#define const__PageMask 0x1fff
-#define const__64bit 0x0
-#define const__NumSizeClasses 0x43
-#define const__MaxSmallSize 0x8000
-#define const__TinySize 0x10
-#define const__TinySizeClass 0x2
-#define const__FixAllocChunk 0x4000
-#define const__MaxMHeapList 0x80
-#define const__HeapAllocChunk 0x100000
-#define const__StackCacheSize 0x8000
-#define const__NumStackOrders 0x3
-#define const__MHeapMap_TotalBits 0x20
-#define const__MHeapMap_Bits 0x13
-#define const__MaxMem 0xffffffff
-#define const__MaxGcproc 0x20
-#define const__MaxArena32 0x80000000
-#define const__FlagNoScan 0x1
-#define const__FlagNoZero 0x2
-#define persistentAlloc__size 8
-#define persistentAlloc_base 0
-#define persistentAlloc_off 4
-#define const_bitPointer 0x1
-#define const_bitMarked 0x10
-#define const_heapBitsShift 0x1
-#define const_heapBitmapScale 0x10
-#define const_bitMarkedAll 0xf0
-#define const_bitPointerAll 0xf
-#define heapBits__size 8
-#define heapBits_bitp 0
-#define heapBits_shift 4
-#define mcache__size 592
-#define mcache_next_sample 0
-#define mcache_local_scan 4
-#define mcache_tiny 8
-#define mcache_tinyoffset 12
-#define mcache_local_tinyallocs 16
-#define mcache_alloc 20
-#define mcache_stackcache 288
-#define mcache_local_nlookup 312
-#define mcache_local_largefree 316
-#define mcache_local_nlargefree 320
-#define mcache_local_nsmallfree 324
-#define gclink__size 4
-#define gclink_next 0
-#define stackfreelist__size 8
-#define stackfreelist_list 0
-#define stackfreelist_size 4
-#define mcentral__size 24
-#define mcentral_lock 0
-#define mcentral_sizeclass 4
-#define mcentral_nonempty 8
-#define mcentral_empty 16
-#define const__MEM_COMMIT 0x1000
-#define const__MEM_RESERVE 0x2000
-#define const__MEM_DECOMMIT 0x4000
-#define const__MEM_RELEASE 0x8000
-#define const__PAGE_READWRITE 0x4
-#define const__PAGE_NOACCESS 0x1
-#define finblock__size 4096
-#define finblock_alllink 0
-#define finblock_next 4
-#define finblock_cnt 8
-#define finblock_fin 16
-#define finalizer__size 20
-#define finalizer_fn 0
-#define finalizer_arg 4
-#define finalizer_nret 8
-#define finalizer_fint 12
-#define finalizer_ot 16
-#define fixalloc__size 32
-#define fixalloc_size 0
-#define fixalloc_first 4
-#define fixalloc_arg 8
-#define fixalloc_list 12
-#define fixalloc_chunk 16
-#define fixalloc_nchunk 20
-#define fixalloc_inuse 24
-#define fixalloc_stat 28
-#define mlink__size 4
-#define mlink_next 0
-#define const__DebugGC 0x0
-#define const__ConcurrentSweep true
-#define const__FinBlockSize 0x1000
-#define const_sweepMinHeapDistance 0x100000
-#define const_defaultHeapMinimum 0x400000
-#define const__GCoff 0x0
-#define const__GCmark 0x1
-#define const__GCmarktermination 0x2
-#define const_gcMarkWorkerDedicatedMode 0x0
-#define const_gcMarkWorkerFractionalMode 0x1
-#define const_gcMarkWorkerIdleMode 0x2
-#define gcControllerState__size 248
-#define gcControllerState_scanWork 0
-#define gcControllerState_bgScanCredit 8
-#define gcControllerState_assistTime 16
-#define gcControllerState_dedicatedMarkTime 24
-#define gcControllerState_fractionalMarkTime 32
-#define gcControllerState_idleMarkTime 40
-#define gcControllerState_bgMarkStartTime 48
-#define gcControllerState_assistStartTime 56
-#define gcControllerState_heapGoal 64
-#define gcControllerState_dedicatedMarkWorkersNeeded 72
-#define gcControllerState_assistWorkPerByte 80
-#define gcControllerState_assistBytesPerWork 88
-#define gcControllerState_fractionalUtilizationGoal 96
-#define gcControllerState_triggerRatio 104
-#define gcControllerState_fractionalMarkWorkersNeeded 176
-#define const_gcGoalUtilization 6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042048p-513
-#define const_gcCreditSlack 0x7d0
-#define const_gcAssistTimeSlack 0x1388
-#define const_gcOverAssistBytes 0x100000
-#define const_gcBackgroundMode 0x0
-#define const_gcForceMode 0x1
-#define const_gcForceBlockMode 0x2
-#define const_fixedRootFinalizers 0x0
-#define const_fixedRootFlushCaches 0x1
-#define const_fixedRootCount 0x2
-#define const_rootBlockBytes 0x40000
-#define const_rootBlockSpans 0x2000
-#define const_gcDrainUntilPreempt 0x1
-#define const_gcDrainNoBlock 0x2
-#define const_gcDrainFlushBgCredit 0x4
-#define const_gcDrainBlock 0x0
-#define sweepdata__size 24
-#define sweepdata_lock 0
-#define sweepdata_g 4
-#define sweepdata_parked 8
-#define sweepdata_started 9
-#define sweepdata_spanidx 12
-#define sweepdata_nbgsweep 16
-#define sweepdata_npausesweep 20
-#define const__Debugwbufs false
-#define const__WorkbufSize 0x800
-#define gcWork__size 24
-#define gcWork_wbuf1 0
-#define gcWork_wbuf2 4
-#define gcWork_bytesMarked 8
-#define gcWork_scanWork 16
-#define workbufhdr__size 36
-#define workbufhdr_node 0
-#define workbufhdr_nobj 12
-#define workbufhdr_inuse 16
-#define workbufhdr_log 20
-#define workbuf__size 2048
-#define workbuf_workbufhdr 0
-#define workbuf_obj 36
-#define mheap__size 8732
-#define mheap_lock 0
-#define mheap_free 4
-#define mheap_freelarge 1028
-#define mheap_busy 1036
-#define mheap_busylarge 2060
-#define mheap_allspans 2068
-#define mheap_gcspans 2072
-#define mheap_nspan 2076
-#define mheap_sweepgen 2080
-#define mheap_sweepdone 2084
-#define mheap_spans 2088
-#define mheap_spans_mapped 2092
-#define mheap_pagesInUse 2096
-#define mheap_spanBytesAlloc 2104
-#define mheap_pagesSwept 2112
-#define mheap_sweepPagesPerByte 2120
-#define mheap_largefree 2128
-#define mheap_nlargefree 2136
-#define mheap_nsmallfree 2144
-#define mheap_bitmap 2680
-#define mheap_bitmap_mapped 2684
-#define mheap_arena_start 2688
-#define mheap_arena_used 2692
-#define mheap_arena_end 2696
-#define mheap_arena_reserved 2700
-#define mheap_central 2704
-#define mheap_spanalloc 8600
-#define mheap_cachealloc 8632
-#define mheap_specialfinalizeralloc 8664
-#define mheap_specialprofilealloc 8696
-#define mheap_speciallock 8728
-#define const__MSpanInUse 0x0
-#define const__MSpanStack 0x1
-#define const__MSpanFree 0x2
-#define const__MSpanDead 0x3
-#define mSpanList__size 8
-#define mSpanList_first 0
-#define mSpanList_last 4
-#define mspan__size 72
-#define mspan_next 0
-#define mspan_prev 4
-#define mspan_list 8
-#define mspan_start 12
-#define mspan_npages 16
-#define mspan_freelist 20
-#define mspan_sweepgen 24
-#define mspan_divMul 28
-#define mspan_ref 32
-#define mspan_sizeclass 34
-#define mspan_incache 35
-#define mspan_state 36
-#define mspan_needzero 37
-#define mspan_divShift 38
-#define mspan_divShift2 39
-#define mspan_elemsize 40
-#define mspan_unusedsince 44
-#define mspan_npreleased 52
-#define mspan_limit 56
-#define mspan_speciallock 60
-#define mspan_specials 64
-#define mspan_baseMask 68
-#define const__KindSpecialFinalizer 0x1
-#define const__KindSpecialProfile 0x2
-#define special__size 8
-#define special_next 0
-#define special_offset 4
-#define special_kind 6
-#define specialfinalizer__size 24
-#define specialfinalizer_special 0
-#define specialfinalizer_fn 8
-#define specialfinalizer_nret 12
-#define specialfinalizer_fint 16
-#define specialfinalizer_ot 20
-#define specialprofile__size 12
-#define specialprofile_special 0
-#define specialprofile_b 8
-#define const_memProfile 0x1
-#define const_blockProfile 0x2
-#define const_buckHashSize 0x2bf1f
-#define const_maxStack 0x20
-#define bucket__size 24
-#define bucket_next 0
-#define bucket_allnext 4
-#define bucket_typ 8
-#define bucket_hash 12
-#define bucket_size 16
-#define bucket_nstk 20
-#define memRecord__size 48
-#define memRecord_allocs 0
-#define memRecord_frees 4
-#define memRecord_alloc_bytes 8
-#define memRecord_free_bytes 12
-#define memRecord_prev_allocs 16
-#define memRecord_prev_frees 20
-#define memRecord_prev_alloc_bytes 24
-#define memRecord_prev_free_bytes 28
-#define memRecord_recent_allocs 32
-#define memRecord_recent_frees 36
-#define memRecord_recent_alloc_bytes 40
-#define memRecord_recent_free_bytes 44
-#define blockRecord__size 16
-#define blockRecord_count 0
-#define blockRecord_cycles 8
-#define StackRecord__size 128
-#define StackRecord_Stack0 0
-#define MemProfileRecord__size 160
-#define MemProfileRecord_AllocBytes 0
-#define MemProfileRecord_FreeBytes 8
-#define MemProfileRecord_AllocObjects 16
-#define MemProfileRecord_FreeObjects 24
-#define MemProfileRecord_Stack0 32
-#define BlockProfileRecord__size 144
-#define BlockProfileRecord_Count 0
-#define BlockProfileRecord_Cycles 8
-#define BlockProfileRecord_StackRecord 16
-#define const_msanenabled false
-#define divMagic__size 16
-#define divMagic_shift 0
-#define divMagic_mul 4
-#define divMagic_shift2 8
-#define divMagic_baseMask 12
-#define mstats__size 5684
-#define mstats_alloc 0
-#define mstats_total_alloc 8
-#define mstats_sys 16
-#define mstats_nlookup 24
-#define mstats_nmalloc 32
-#define mstats_nfree 40
-#define mstats_heap_alloc 48
-#define mstats_heap_sys 56
-#define mstats_heap_idle 64
-#define mstats_heap_inuse 72
-#define mstats_heap_released 80
-#define mstats_heap_objects 88
-#define mstats_stacks_inuse 96
-#define mstats_stacks_sys 104
-#define mstats_mspan_inuse 112
-#define mstats_mspan_sys 120
-#define mstats_mcache_inuse 128
-#define mstats_mcache_sys 136
-#define mstats_buckhash_sys 144
-#define mstats_gc_sys 152
-#define mstats_other_sys 160
-#define mstats_next_gc 168
-#define mstats_last_gc 176
-#define mstats_pause_total_ns 184
-#define mstats_pause_ns 192
-#define mstats_pause_end 2240
-#define mstats_numgc 4288
-#define mstats_gc_cpu_fraction 4292
-#define mstats_enablegc 4300
-#define mstats_debuggc 4301
-#define mstats_by_size 4304
-#define mstats_tinyallocs 5644
-#define mstats_heap_live 5652
-#define mstats_heap_scan 5660
-#define mstats_heap_marked 5668
-#define mstats_heap_reachable 5676
-#define MemStats__size 5524
-#define MemStats_Alloc 0
-#define MemStats_TotalAlloc 8
-#define MemStats_Sys 16
-#define MemStats_Lookups 24
-#define MemStats_Mallocs 32
-#define MemStats_Frees 40
-#define MemStats_HeapAlloc 48
-#define MemStats_HeapSys 56
-#define MemStats_HeapIdle 64
-#define MemStats_HeapInuse 72
-#define MemStats_HeapReleased 80
-#define MemStats_HeapObjects 88
-#define MemStats_StackInuse 96
-#define MemStats_StackSys 104
-#define MemStats_MSpanInuse 112
-#define MemStats_MSpanSys 120
-#define MemStats_MCacheInuse 128
-#define MemStats_MCacheSys 136
-#define MemStats_BuckHashSys 144
-#define MemStats_GCSys 152
-#define MemStats_OtherSys 160
-#define MemStats_NextGC 168
-#define MemStats_LastGC 176
-#define MemStats_PauseTotalNs 184
-#define MemStats_PauseNs 192
-#define MemStats_PauseEnd 2240
-#define MemStats_NumGC 4288
-#define MemStats_GCCPUFraction 4292
-#define MemStats_EnableGC 4300
-#define MemStats_DebugGC 4301
-#define MemStats_BySize 4304
-#define const_debugStackBarrier false
-#define const_pdReady 0x1
-#define const_pdWait 0x2
-#define const_pollBlockSize 0x1000
-#define pollDesc__size 120
-#define pollDesc_link 0
-#define pollDesc_lock 4
-#define pollDesc_fd 8
-#define pollDesc_closing 12
-#define pollDesc_seq 16
-#define pollDesc_rg 20
-#define pollDesc_rt 24
-#define pollDesc_rd 60
-#define pollDesc_wg 68
-#define pollDesc_wt 72
-#define pollDesc_wd 108
-#define pollDesc_user 116
-#define pollCache__size 8
-#define pollCache_lock 0
-#define pollCache_first 4
-#define const__DWORD_MAX 0xffffffff
-#define const__INVALID_HANDLE_VALUE 0xffffffff
-#define net_op__size 36
-#define net_op_o 0
-#define net_op_pd 20
-#define net_op_mode 24
-#define net_op_errno 28
-#define net_op_qty 32
-#define overlappedEntry__size 16
-#define overlappedEntry_key 0
-#define overlappedEntry_op 4
-#define overlappedEntry_internal 8
-#define overlappedEntry_qty 12
-#define sigset__size 0
-#define const_currentProcess 0xffffffff
-#define const_currentThread 0xfffffffe
-#define _KSYSTEM_TIME__size 12
-#define _KSYSTEM_TIME_LowPart 0
-#define _KSYSTEM_TIME_High1Time 4
-#define _KSYSTEM_TIME_High2Time 8
-#define const__INTERRUPT_TIME 0x7ffe0008
-#define const__SYSTEM_TIME 0x7ffe0014
-#define const__NSIG 0x41
-#define mOS__size 4
-#define mOS_waitsema 0
-#define const_deferHeaderSize 0x1c
-#define const_minDeferAlloc 0x20
-#define const_minDeferArgs 0x4
-#define parfor__size 76
-#define parfor_body 0
-#define parfor_done 4
-#define parfor_nthr 8
-#define parfor_thrseq 12
-#define parfor_cnt 16
-#define parfor_wait 20
-#define parfor_thr 24
-#define parfor_nsteal 36
-#define parfor_nstealcnt 44
-#define parfor_nprocyield 52
-#define parfor_nosyield 60
-#define parfor_nsleep 68
-#define parforthread__size 112
-#define parforthread_pos 0
-#define parforthread_nsteal 8
-#define parforthread_nstealcnt 16
-#define parforthread_nprocyield 24
-#define parforthread_nosyield 32
-#define parforthread_nsleep 40
-#define parforthread_pad 48
-#define const__GoidCacheBatch 0x10
-#define const_freezeStopWait 0x7fffffff
-#define cgothreadstart__size 12
-#define cgothreadstart_g 0
-#define cgothreadstart_tls 4
-#define cgothreadstart_fn 8
-#define const_forcePreemptNS 0x989680
-#define const_randomizeScheduler false
-#define const_raceenabled false
-#define const_bit1 0x7
-#define const_bitx 0x6
-#define const_bit2 0x5
-#define const_bit3 0x4
-#define const_bit4 0x3
-#define const_bit5 0x2
-#define const_t1 0x0
-#define const_tx 0x80
-#define const_t2 0xc0
-#define const_t3 0xe0
-#define const_t4 0xf0
-#define const_t5 0xf8
-#define const_rune1 0x7f
-#define const_rune2 0x7ff
-#define const_rune3 0xffff
-#define const_rune4 0x1fffff
-#define const_maskx 0x3f
-#define const_testx 0xc0
-#define const_runeerror 0xfffd
-#define const_runeself 0x80
-#define const_surrogateMin 0xd800
-#define const_surrogateMax 0xdfff
-#define const_bad 0xfffd
-#define const_runemax 0x10ffff
-#define const_tracebackCrash 0x1
-#define const_tracebackAll 0x2
-#define const_tracebackShift 0x2
-#define dbgVar__size 12
-#define dbgVar_name 0
-#define dbgVar_value 8
-#define const__Gidle 0x0
-#define const__Grunnable 0x1
-#define const__Grunning 0x2
-#define const__Gsyscall 0x3
-#define const__Gwaiting 0x4
-#define const__Gmoribund_unused 0x5
-#define const__Gdead 0x6
-#define const__Genqueue 0x7
-#define const__Gcopystack 0x8
-#define const__Gscan 0x1000
-#define const__Gscanrunnable 0x1001
-#define const__Gscanrunning 0x1002
-#define const__Gscansyscall 0x1003
-#define const__Gscanwaiting 0x1004
-#define const__Gscanenqueue 0x1007
-#define const__Pidle 0x0
-#define const__Prunning 0x1
-#define const__Psyscall 0x2
-#define const__Pgcstop 0x3
-#define const__Pdead 0x4
-#define mutex__size 4
-#define mutex_key 0
-#define note__size 4
-#define note_key 0
-#define funcval__size 4
-#define funcval_fn 0
-#define iface__size 8
-#define iface_tab 0
-#define iface_data 4
-#define eface__size 8
-#define eface__type 0
-#define eface_data 4
-#define gobuf__size 28
-#define gobuf_sp 0
-#define gobuf_pc 4
-#define gobuf_g 8
-#define gobuf_ctxt 12
-#define gobuf_ret 16
-#define gobuf_lr 20
-#define gobuf_bp 24
-#define sudog__size 36
-#define sudog_g 0
-#define sudog_selectdone 4
-#define sudog_next 8
-#define sudog_prev 12
-#define sudog_elem 16
-#define sudog_releasetime 20
-#define sudog_nrelease 28
-#define sudog_waitlink 32
-#define gcstats__size 40
-#define gcstats_nhandoff 0
-#define gcstats_nhandoffcnt 8
-#define gcstats_nprocyield 16
-#define gcstats_nosyield 24
-#define gcstats_nsleep 32
-#define libcall__size 24
-#define libcall_fn 0
-#define libcall_n 4
-#define libcall_args 8
-#define libcall_r1 12
-#define libcall_r2 16
-#define libcall_err 20
-#define wincallbackcontext__size 16
-#define wincallbackcontext_gobody 0
-#define wincallbackcontext_argsize 4
-#define wincallbackcontext_restorestack 8
-#define wincallbackcontext_cleanstack 12
-#define stack__size 8
-#define stack_lo 0
-#define stack_hi 4
-#define stkbar__size 8
-#define stkbar_savedLRPtr 0
-#define stkbar_savedLRVal 4
-#define g__size 208
-#define g_stack 0
-#define g_stackguard0 8
-#define g_stackguard1 12
-#define g__panic 16
-#define g__defer 20
-#define g_m 24
-#define g_stackAlloc 28
-#define g_sched 32
-#define g_syscallsp 60
-#define g_syscallpc 64
-#define g_stkbar 68
-#define g_stkbarPos 80
-#define g_stktopsp 84
-#define g_param 88
-#define g_atomicstatus 92
-#define g_stackLock 96
-#define g_goid 100
-#define g_waitsince 108
-#define g_waitreason 116
-#define g_schedlink 124
-#define g_preempt 128
-#define g_paniconfault 129
-#define g_preemptscan 130
-#define g_gcscandone 131
-#define g_gcscanvalid 132
-#define g_throwsplit 133
-#define g_raceignore 134
-#define g_sysblocktraced 135
-#define g_sysexitticks 136
-#define g_sysexitseq 144
-#define g_lockedm 152
-#define g_sig 156
-#define g_writebuf 160
-#define g_sigcode0 172
-#define g_sigcode1 176
-#define g_sigpc 180
-#define g_gopc 184
-#define g_startpc 188
-#define g_racectx 192
-#define g_waiting 196
-#define g_gcAssistBytes 200
-#define m__size 584
-#define m_g0 0
-#define m_morebuf 4
-#define m_divmod 32
-#define m_procid 36
-#define m_gsignal 44
-#define m_sigmask 48
-#define m_tls 48
-#define m_mstartfn 72
-#define m_curg 76
-#define m_caughtsig 80
-#define m_p 84
-#define m_nextp 88
-#define m_id 92
-#define m_mallocing 96
-#define m_throwing 100
-#define m_preemptoff 104
-#define m_locks 112
-#define m_softfloat 116
-#define m_dying 120
-#define m_profilehz 124
-#define m_helpgc 128
-#define m_spinning 132
-#define m_blocked 133
-#define m_inwb 134
-#define m_newSigstack 135
-#define m_printlock 136
-#define m_fastrand 140
-#define m_ncgocall 144
-#define m_ncgo 152
-#define m_park 156
-#define m_alllink 160
-#define m_schedlink 164
-#define m_machport 168
-#define m_mcache 172
-#define m_lockedg 176
-#define m_createstack 180
-#define m_freglo 308
-#define m_freghi 372
-#define m_fflag 436
-#define m_locked 440
-#define m_nextwaitm 444
-#define m_gcstats 448
-#define m_needextram 488
-#define m_traceback 489
-#define m_waitunlockf 492
-#define m_waitlock 496
-#define m_waittraceev 500
-#define m_waittraceskip 504
-#define m_startingtrace 508
-#define m_syscalltick 512
-#define m_thread 516
-#define m_libcall 520
-#define m_libcallpc 544
-#define m_libcallsp 548
-#define m_libcallg 552
-#define m_syscall 556
-#define m_mOS 580
-#define p__size 2436
-#define p_lock 0
-#define p_id 4
-#define p_status 8
-#define p_link 12
-#define p_schedtick 16
-#define p_syscalltick 20
-#define p_m 24
-#define p_mcache 28
-#define p_deferpool 32
-#define p_deferpoolbuf 92
-#define p_goidcache 732
-#define p_goidcacheend 740
-#define p_runqhead 748
-#define p_runqtail 752
-#define p_runq 756
-#define p_runnext 1780
-#define p_gfree 1784
-#define p_gfreecnt 1788
-#define p_sudogcache 1792
-#define p_sudogbuf 1804
-#define p_tracebuf 2316
-#define p_palloc 2320
-#define p_gcAssistTime 2328
-#define p_gcBgMarkWorker 2336
-#define p_gcMarkWorkerMode 2340
-#define p_gcw 2344
-#define p_runSafePointFn 2368
-#define p_pad 2372
-#define const__MaxGomaxprocs 0x100
-#define schedt__size 164
-#define schedt_goidgen 0
-#define schedt_lastpoll 8
-#define schedt_lock 16
-#define schedt_midle 20
-#define schedt_nmidle 24
-#define schedt_nmidlelocked 28
-#define schedt_mcount 32
-#define schedt_maxmcount 36
-#define schedt_ngsys 40
-#define schedt_pidle 44
-#define schedt_npidle 48
-#define schedt_nmspinning 52
-#define schedt_runqhead 56
-#define schedt_runqtail 60
-#define schedt_runqsize 64
-#define schedt_gflock 68
-#define schedt_gfree 72
-#define schedt_ngfree 76
-#define schedt_sudoglock 80
-#define schedt_sudogcache 84
-#define schedt_deferlock 88
-#define schedt_deferpool 92
-#define schedt_gcwaiting 112
-#define schedt_stopwait 116
-#define schedt_stopnote 120
-#define schedt_sysmonwait 124
-#define schedt_sysmonnote 128
-#define schedt_safePointFn 132
-#define schedt_safePointWait 136
-#define schedt_safePointNote 140
-#define schedt_profilehz 144
-#define schedt_procresizetime 148
-#define schedt_totaltime 156
-#define const__LockExternal 0x1
-#define const__LockInternal 0x2
-#define sigtabtt__size 8
-#define sigtabtt_flags 0
-#define sigtabtt_name 4
-#define const__SigNotify 0x1
-#define const__SigKill 0x2
-#define const__SigThrow 0x4
-#define const__SigPanic 0x8
-#define const__SigDefault 0x10
-#define const__SigHandling 0x20
-#define const__SigGoExit 0x40
-#define const__SigSetStack 0x80
-#define const__SigUnblock 0x100
-#define _func__size 36
-#define _func_entry 0
-#define _func_nameoff 4
-#define _func_args 8
-#define _func_pcsp 16
-#define _func_pcfile 20
-#define _func_pcln 24
-#define _func_npcdata 28
-#define _func_nfuncdata 32
-#define itab__size 24
-#define itab_inter 0
-#define itab__type 4
-#define itab_link 8
-#define itab_bad 12
-#define itab_unused 16
-#define itab_fun 20
-#define lfnode__size 12
-#define lfnode_next 0
-#define lfnode_pushcnt 8
-#define forcegcstate__size 12
-#define forcegcstate_lock 0
-#define forcegcstate_g 4
-#define forcegcstate_idle 8
-#define const__Structrnd 0x4
-#define _defer__size 28
-#define _defer_siz 0
-#define _defer_started 4
-#define _defer_sp 8
-#define _defer_pc 12
-#define _defer_fn 16
-#define _defer__panic 20
-#define _defer_link 24
-#define _panic__size 20
-#define _panic_argp 0
-#define _panic_arg 4
-#define _panic_link 12
-#define _panic_recovered 16
-#define _panic_aborted 17
-#define stkframe__size 40
-#define stkframe_fn 0
-#define stkframe_pc 4
-#define stkframe_continpc 8
-#define stkframe_lr 12
-#define stkframe_sp 16
-#define stkframe_fp 20
-#define stkframe_varp 24
-#define stkframe_argp 28
-#define stkframe_arglen 32
-#define stkframe_argmap 36
-#define const__TraceRuntimeFrames 0x1
-#define const__TraceTrap 0x2
-#define const__TraceJumpStack 0x4
-#define const__TracebackMaxFrames 0x64
-#define const_debugSelect false
-#define const_caseRecv 0x1
-#define const_caseSend 0x2
-#define const_caseDefault 0x3
-#define hselect__size 40
-#define hselect_tcase 0
-#define hselect_ncase 2
-#define hselect_pollorder 4
-#define hselect_lockorder 8
-#define hselect_scase 12
-#define scase__size 28
-#define scase_elem 0
-#define scase_c 4
-#define scase_pc 8
-#define scase_kind 12
-#define scase_so 14
-#define scase_receivedp 16
-#define scase_releasetime 20
-#define runtimeSelect__size 16
-#define runtimeSelect_dir 0
-#define runtimeSelect_typ 4
-#define runtimeSelect_ch 8
-#define runtimeSelect_val 12
-#define const_selectSend 0x1
-#define const_selectRecv 0x2
-#define const_selectDefault 0x3
-#define semaRoot__size 16
-#define semaRoot_lock 0
-#define semaRoot_head 4
-#define semaRoot_tail 8
-#define semaRoot_nwait 12
-#define const_semTabSize 0xfb
-#define syncSema__size 12
-#define syncSema_lock 0
-#define syncSema_head 4
-#define syncSema_tail 8
-#define const_sigIdle 0x0
-#define const_sigReceiving 0x1
-#define const_sigSending 0x2
-#define slice__size 12
-#define slice_array 0
-#define slice_len 4
-#define slice_cap 8
-#define const_mantbits64 0x34
-#define const_expbits64 0xb
-#define const_bias64 -0x3ff
-#define const_nan64 0x7ff0000000000001
-#define const_inf64 0x7ff0000000000000
-#define const_neg64 0x8000000000000000
-#define const_mantbits32 0x17
-#define const_expbits32 0x8
-#define const_bias32 -0x7f
-#define const_nan32 0x7f800001
-#define const_inf32 0x7f800000
-#define const_neg32 0x80000000
-#define const_float64Mask 0x7ff
-#define const_float64Shift 0x34
-#define const_float64Bias 0x3ff
-#define const_float64NaN 0x7ff8000000000001
-#define const_float64Inf 0x7ff0000000000000
-#define const_maxFloat64 13407807929942595611008317640802934282466402440167209614001403565979142097391741634560602662607475277315483922957140688461601785037921060160934708985602772p+512
-#define const__StackSystem 0x800
-#define const__StackMin 0x800
-#define const__FixedStack0 0x1000
-#define const__FixedStack1 0xfff
-#define const__FixedStack2 0xfff
-#define const__FixedStack3 0xfff
-#define const__FixedStack4 0xfff
-#define const__FixedStack5 0xfff
-#define const__FixedStack6 0xfff
-#define const__FixedStack 0x1000
-#define const__StackBig 0x1000
-#define const__StackGuard 0xad0
-#define const__StackSmall 0x80
-#define const__StackLimit 0x250
-#define const__StackPreempt 0xfffffade
-#define const__StackFork 0xfffffb2e
-#define const_stackDebug 0x0
-#define const_stackFromSystem 0x0
-#define const_stackFaultOnFree 0x0
-#define const_stackPoisonCopy 0x0
-#define const_stackCache 0x1
-#define const_uintptrMask 0xffffffff
-#define const_poisonStack 0x68686868
-#define const_stackPreempt 0xfffffade
-#define const_stackFork 0xfffffb2e
-#define adjustinfo__size 204
-#define adjustinfo_old 0
-#define adjustinfo_delta 8
-#define adjustinfo_cache 12
-#define bitvector__size 8
-#define bitvector_n 0
-#define bitvector_bytedata 4
-#define gobitvector__size 16
-#define gobitvector_n 0
-#define gobitvector_bytedata 4
-#define const_tmpStringBufSize 0x20
-#define stringStruct__size 8
-#define stringStruct_str 0
-#define stringStruct_len 4
-#define stringStructDWARF__size 8
-#define stringStructDWARF_str 0
-#define stringStructDWARF_len 4
-#define neverCallThisFunction__size 0
-#define const__NoArgs 0xffffffff
-#define Func__size 0
-#define Func_opaque 0
-#define const__PCDATA_StackMapIndex 0x0
-#define const__FUNCDATA_ArgsPointerMaps 0x0
-#define const__FUNCDATA_LocalsPointerMaps 0x1
-#define const__ArgsSizeUnknown -0x80000000
-#define moduledata__size 152
-#define moduledata_pclntable 0
-#define moduledata_ftab 12
-#define moduledata_filetab 24
-#define moduledata_findfunctab 36
-#define moduledata_minpc 40
-#define moduledata_maxpc 44
-#define moduledata_text 48
-#define moduledata_etext 52
-#define moduledata_noptrdata 56
-#define moduledata_enoptrdata 60
-#define moduledata_data 64
-#define moduledata_edata 68
-#define moduledata_bss 72
-#define moduledata_ebss 76
-#define moduledata_noptrbss 80
-#define moduledata_enoptrbss 84
-#define moduledata_end 88
-#define moduledata_gcdata 92
-#define moduledata_gcbss 96
-#define moduledata_typelinks 100
-#define moduledata_modulename 112
-#define moduledata_modulehashes 120
-#define moduledata_gcdatamask 132
-#define moduledata_gcbssmask 140
-#define moduledata_next 148
-#define modulehash__size 20
-#define modulehash_modulename 0
-#define modulehash_linktimehash 8
-#define modulehash_runtimehash 16
-#define functab__size 8
-#define functab_entry 0
-#define functab_funcoff 4
-#define const_minfunc 0x10
-#define const_pcbucketsize 0x1000
-#define findfuncbucket__size 20
-#define findfuncbucket_idx 0
-#define findfuncbucket_subbuckets 4
-#define const_debugPcln false
-#define pcvalueCache__size 192
-#define pcvalueCache_entries 0
-#define pcvalueCacheEnt__size 12
-#define pcvalueCacheEnt_targetpc 0
-#define pcvalueCacheEnt_off 4
-#define pcvalueCacheEnt_val 8
-#define stackmap__size 12
-#define stackmap_n 0
-#define stackmap_nbit 4
-#define stackmap_bytedata 8
-#define callbacks__size 8008
-#define callbacks_lock 0
-#define callbacks_ctxt 4
-#define callbacks_n 8004
-#define timer__size 36
-#define timer_i 0
-#define timer_when 4
-#define timer_period 12
-#define timer_f 20
-#define timer_arg 24
-#define timer_seq 32
-#define const_traceEvNone 0x0
-#define const_traceEvBatch 0x1
-#define const_traceEvFrequency 0x2
-#define const_traceEvStack 0x3
-#define const_traceEvGomaxprocs 0x4
-#define const_traceEvProcStart 0x5
-#define const_traceEvProcStop 0x6
-#define const_traceEvGCStart 0x7
-#define const_traceEvGCDone 0x8
-#define const_traceEvGCScanStart 0x9
-#define const_traceEvGCScanDone 0xa
-#define const_traceEvGCSweepStart 0xb
-#define const_traceEvGCSweepDone 0xc
-#define const_traceEvGoCreate 0xd
-#define const_traceEvGoStart 0xe
-#define const_traceEvGoEnd 0xf
-#define const_traceEvGoStop 0x10
-#define const_traceEvGoSched 0x11
-#define const_traceEvGoPreempt 0x12
-#define const_traceEvGoSleep 0x13
-#define const_traceEvGoBlock 0x14
-#define const_traceEvGoUnblock 0x15
-#define const_traceEvGoBlockSend 0x16
-#define const_traceEvGoBlockRecv 0x17
-#define const_traceEvGoBlockSelect 0x18
-#define const_traceEvGoBlockSync 0x19
-#define const_traceEvGoBlockCond 0x1a
-#define const_traceEvGoBlockNet 0x1b
-#define const_traceEvGoSysCall 0x1c
-#define const_traceEvGoSysExit 0x1d
-#define const_traceEvGoSysBlock 0x1e
-#define const_traceEvGoWaiting 0x1f
-#define const_traceEvGoInSyscall 0x20
-#define const_traceEvHeapAlloc 0x21
-#define const_traceEvNextGC 0x22
-#define const_traceEvTimerGoroutine 0x23
-#define const_traceEvFutileWakeup 0x24
-#define const_traceEvCount 0x25
-#define const_traceTickDiv 0x40
-#define const_traceStackSize 0x80
-#define const_traceGlobProc -0x1
-#define const_traceBytesPerNumber 0xa
-#define const_traceArgCountShift 0x6
-#define const_traceFutileWakeup 0x80
-#define traceBufHeader__size 536
-#define traceBufHeader_link 0
-#define traceBufHeader_lastSeq 4
-#define traceBufHeader_lastTicks 12
-#define traceBufHeader_pos 20
-#define traceBufHeader_stk 24
-#define traceBuf__size 65536
-#define traceBuf_traceBufHeader 0
-#define traceBuf_arr 536
-#define traceStackTable__size 32784
-#define traceStackTable_lock 0
-#define traceStackTable_seq 4
-#define traceStackTable_mem 8
-#define traceStackTable_tab 16
-#define traceStack__size 20
-#define traceStack_link 0
-#define traceStack_hash 4
-#define traceStack_id 8
-#define traceStack_n 12
-#define traceStack_stk 16
-#define traceAlloc__size 8
-#define traceAlloc_head 0
-#define traceAlloc_off 4
-#define traceAllocBlock__size 65536
-#define traceAllocBlock_next 0
-#define traceAllocBlock_data 4
-#define const_usesLR false
-#define _type__size 36
-#define _type_size 0
-#define _type_ptrdata 4
-#define _type_hash 8
-#define _type__unused 12
-#define _type_align 13
-#define _type_fieldalign 14
-#define _type_kind 15
-#define _type_alg 16
-#define _type_gcdata 20
-#define _type__string 24
-#define _type_x 28
-#define _type_ptrto 32
-#define method__size 24
-#define method_name 0
-#define method_pkgpath 4
-#define method_mtyp 8
-#define method_typ 12
-#define method_ifn 16
-#define method_tfn 20
-#define uncommontype__size 20
-#define uncommontype_name 0
-#define uncommontype_pkgpath 4
-#define uncommontype_mhdr 8
-#define imethod__size 12
-#define imethod_name 0
-#define imethod_pkgpath 4
-#define imethod__type 8
-#define interfacetype__size 48
-#define interfacetype_typ 0
-#define interfacetype_mhdr 36
-#define maptype__size 60
-#define maptype_typ 0
-#define maptype_key 36
-#define maptype_elem 40
-#define maptype_bucket 44
-#define maptype_hmap 48
-#define maptype_keysize 52
-#define maptype_indirectkey 53
-#define maptype_valuesize 54
-#define maptype_indirectvalue 55
-#define maptype_bucketsize 56
-#define maptype_reflexivekey 58
-#define maptype_needkeyupdate 59
-#define arraytype__size 48
-#define arraytype_typ 0
-#define arraytype_elem 36
-#define arraytype_slice 40
-#define arraytype_len 44
-#define chantype__size 44
-#define chantype_typ 0
-#define chantype_elem 36
-#define chantype_dir 40
-#define slicetype__size 40
-#define slicetype_typ 0
-#define slicetype_elem 36
-#define functype__size 64
-#define functype_typ 0
-#define functype_dotdotdot 36
-#define functype_in 40
-#define functype_out 52
-#define ptrtype__size 40
-#define ptrtype_typ 0
-#define ptrtype_elem 36
-#define structfield__size 20
-#define structfield_name 0
-#define structfield_pkgpath 4
-#define structfield_typ 8
-#define structfield_tag 12
-#define structfield_offset 16
-#define structtype__size 48
-#define structtype_typ 0
-#define structtype_fields 36
-#define const_kindBool 0x1
-#define const_kindInt 0x2
-#define const_kindInt8 0x3
-#define const_kindInt16 0x4
-#define const_kindInt32 0x5
-#define const_kindInt64 0x6
-#define const_kindUint 0x7
-#define const_kindUint8 0x8
-#define const_kindUint16 0x9
-#define const_kindUint32 0xa
-#define const_kindUint64 0xb
-#define const_kindUintptr 0xc
-#define const_kindFloat32 0xd
-#define const_kindFloat64 0xe
-#define const_kindComplex64 0xf
-#define const_kindComplex128 0x10
-#define const_kindArray 0x11
-#define const_kindChan 0x12
-#define const_kindFunc 0x13
-#define const_kindInterface 0x14
-#define const_kindMap 0x15
-#define const_kindPtr 0x16
-#define const_kindSlice 0x17
-#define const_kindString 0x18
-#define const_kindStruct 0x19
-#define const_kindUnsafePointer 0x1a
-#define const_kindDirectIface 0x20
-#define const_kindGCProg 0x40
-#define const_kindNoPointers 0x80
-#define const_kindMask 0x1f
-#define const_sign32 0x80000000
-#define const_sign64 0x8000000000000000
-#define const_cb_max 0x7d0
@@ -1,1376 +0,0 @@
-// generated by 6g -asmhdr from package runtime
-
-#define const_c0 0x756ea16a56a621
-#define const_c1 0x52ef6bbb8f63bf
-#define const_alg_MEM 0x0
-#define const_alg_MEM0 0x1
-#define const_alg_MEM8 0x2
-#define const_alg_MEM16 0x3
-#define const_alg_MEM32 0x4
-#define const_alg_MEM64 0x5
-#define const_alg_MEM128 0x6
-#define const_alg_NOEQ 0x7
-#define const_alg_NOEQ0 0x8
-#define const_alg_NOEQ8 0x9
-#define const_alg_NOEQ16 0xa
-#define const_alg_NOEQ32 0xb
-#define const_alg_NOEQ64 0xc
-#define const_alg_NOEQ128 0xd
-#define const_alg_STRING 0xe
-#define const_alg_INTER 0xf
-#define const_alg_NILINTER 0x10
-#define const_alg_SLICE 0x11
-#define const_alg_FLOAT32 0x12
-#define const_alg_FLOAT64 0x13
-#define const_alg_CPLX64 0x14
-#define const_alg_CPLX128 0x15
-#define const_alg_max 0x16
-#define typeAlg__size 16
-#define typeAlg_hash 0
-#define typeAlg_equal 8
-#define const_hashRandomBytes 0x80
-#define const_cgoCheckPointerFail "cgo argument has Go pointer to Go pointer"
-#define const_cgoResultFail "cgo result has Go pointer"
-#define const_cgoWriteBarrierFail "Go pointer stored into non-Go memory"
-#define const_maxAlign 0x8
-#define const_hchanSize 0x60
-#define const_debugChan false
-#define hchan__size 96
-#define hchan_qcount 0
-#define hchan_dataqsiz 8
-#define hchan_buf 16
-#define hchan_elemsize 24
-#define hchan_closed 28
-#define hchan_elemtype 32
-#define hchan_sendx 40
-#define hchan_recvx 48
-#define hchan_recvq 56
-#define hchan_sendq 72
-#define hchan_lock 88
-#define waitq__size 16
-#define waitq_first 0
-#define waitq_last 8
-#define const_Compiler "gc"
-#define const_numBuckets 0x400
-#define const_logSize 0x20000
-#define const_assoc 0x4
-#define const_maxCPUProfStack 0x40
-#define cpuprofEntry__size 528
-#define cpuprofEntry_count 0
-#define cpuprofEntry_depth 8
-#define cpuprofEntry_stack 16
-#define cpuProfile__size 3211328
-#define cpuProfile_on 0
-#define cpuProfile_wait 8
-#define cpuProfile_count 16
-#define cpuProfile_evicts 24
-#define cpuProfile_lost 32
-#define cpuProfile_hash 40
-#define cpuProfile_log 2162728
-#define cpuProfile_nlog 3211304
-#define cpuProfile_toggle 3211312
-#define cpuProfile_handoff 3211316
-#define cpuProfile_wtoggle 3211320
-#define cpuProfile_wholding 3211324
-#define cpuProfile_flushing 3211325
-#define cpuProfile_eodSent 3211326
-#define const__PROT_NONE 0x0
-#define const__PROT_READ 0x1
-#define const__PROT_WRITE 0x2
-#define const__PROT_EXEC 0x4
-#define const__MAP_ANON 0x1
-#define const__MAP_PRIVATE 0x2
-#define const__DUPLICATE_SAME_ACCESS 0x2
-#define const__THREAD_PRIORITY_HIGHEST 0x2
-#define const__SIGINT 0x2
-#define const__CTRL_C_EVENT 0x0
-#define const__CTRL_BREAK_EVENT 0x1
-#define const__CONTEXT_CONTROL 0x100001
-#define const__CONTEXT_FULL 0x10000b
-#define const__EXCEPTION_ACCESS_VIOLATION 0xc0000005
-#define const__EXCEPTION_BREAKPOINT 0x80000003
-#define const__EXCEPTION_FLT_DENORMAL_OPERAND 0xc000008d
-#define const__EXCEPTION_FLT_DIVIDE_BY_ZERO 0xc000008e
-#define const__EXCEPTION_FLT_INEXACT_RESULT 0xc000008f
-#define const__EXCEPTION_FLT_OVERFLOW 0xc0000091
-#define const__EXCEPTION_FLT_UNDERFLOW 0xc0000093
-#define const__EXCEPTION_INT_DIVIDE_BY_ZERO 0xc0000094
-#define const__EXCEPTION_INT_OVERFLOW 0xc0000095
-#define const__INFINITE 0xffffffff
-#define const__WAIT_TIMEOUT 0x102
-#define const__EXCEPTION_CONTINUE_EXECUTION -0x1
-#define const__EXCEPTION_CONTINUE_SEARCH 0x0
-#define systeminfo__size 48
-#define systeminfo_anon0 0
-#define systeminfo_dwpagesize 4
-#define systeminfo_lpminimumapplicationaddress 8
-#define systeminfo_lpmaximumapplicationaddress 16
-#define systeminfo_dwactiveprocessormask 24
-#define systeminfo_dwnumberofprocessors 32
-#define systeminfo_dwprocessortype 36
-#define systeminfo_dwallocationgranularity 40
-#define systeminfo_wprocessorlevel 44
-#define systeminfo_wprocessorrevision 46
-#define exceptionrecord__size 152
-#define exceptionrecord_exceptioncode 0
-#define exceptionrecord_exceptionflags 4
-#define exceptionrecord_exceptionrecord 8
-#define exceptionrecord_exceptionaddress 16
-#define exceptionrecord_numberparameters 24
-#define exceptionrecord_pad_cgo_0 28
-#define exceptionrecord_exceptioninformation 32
-#define m128a__size 16
-#define m128a_low 0
-#define m128a_high 8
-#define context__size 1232
-#define context_p1home 0
-#define context_p2home 8
-#define context_p3home 16
-#define context_p4home 24
-#define context_p5home 32
-#define context_p6home 40
-#define context_contextflags 48
-#define context_mxcsr 52
-#define context_segcs 56
-#define context_segds 58
-#define context_seges 60
-#define context_segfs 62
-#define context_seggs 64
-#define context_segss 66
-#define context_eflags 68
-#define context_dr0 72
-#define context_dr1 80
-#define context_dr2 88
-#define context_dr3 96
-#define context_dr6 104
-#define context_dr7 112
-#define context_rax 120
-#define context_rcx 128
-#define context_rdx 136
-#define context_rbx 144
-#define context_rsp 152
-#define context_rbp 160
-#define context_rsi 168
-#define context_rdi 176
-#define context_r8 184
-#define context_r9 192
-#define context_r10 200
-#define context_r11 208
-#define context_r12 216
-#define context_r13 224
-#define context_r14 232
-#define context_r15 240
-#define context_rip 248
-#define context_anon0 256
-#define context_vectorregister 768
-#define context_vectorcontrol 1184
-#define context_debugcontrol 1192
-#define context_lastbranchtorip 1200
-#define context_lastbranchfromrip 1208
-#define context_lastexceptiontorip 1216
-#define context_lastexceptionfromrip 1224
-#define overlapped__size 32
-#define overlapped_internal 0
-#define overlapped_internalhigh 8
-#define overlapped_anon0 16
-#define overlapped_hevent 24
-#define TypeAssertionError__size 64
-#define TypeAssertionError_interfaceString 0
-#define TypeAssertionError_concreteString 16
-#define TypeAssertionError_assertedString 32
-#define TypeAssertionError_missingMethod 48
-#define const_GOOS "windows"
-#define const_GOARCH "amd64"
-#define const_fastlogNumBits 0x5
-#define const_m1 0xea38ec9079f01541
-#define const_m2 0x2723a30d96da1399
-#define const_m3 0x83cf8eadf876d2d7
-#define const_m4 0xdbcfc27b643df693
-#define const_bucketCntBits 0x3
-#define const_bucketCnt 0x8
-#define const_loadFactor 10893843943078360143403895311042249978576984729231319619400393673023933274434756918651522867260609034998150884776519916318675029784706588081477339817443328p-509
-#define const_maxKeySize 0x80
-#define const_maxValueSize 0x80
-#define const_dataOffset 0x8
-#define const_empty 0x0
-#define const_evacuatedEmpty 0x1
-#define const_evacuatedX 0x2
-#define const_evacuatedY 0x3
-#define const_minTopHash 0x4
-#define const_iterator 0x1
-#define const_oldIterator 0x2
-#define const_hashWriting 0x4
-#define const_noCheck 0xffffffffffffffff
-#define hmap__size 48
-#define hmap_count 0
-#define hmap_flags 8
-#define hmap_B 9
-#define hmap_hash0 12
-#define hmap_buckets 16
-#define hmap_oldbuckets 24
-#define hmap_nevacuate 32
-#define hmap_overflow 40
-#define bmap__size 8
-#define bmap_tophash 0
-#define hiter__size 96
-#define hiter_key 0
-#define hiter_value 8
-#define hiter_t 16
-#define hiter_h 24
-#define hiter_buckets 32
-#define hiter_bptr 40
-#define hiter_overflow 48
-#define hiter_startBucket 64
-#define hiter_offset 72
-#define hiter_wrapped 73
-#define hiter_B 74
-#define hiter_i 75
-#define hiter_bucket 80
-#define hiter_checkBucket 88
-#define const_initialZeroSize 0x400
-#define const_fieldKindEol 0x0
-#define const_fieldKindPtr 0x1
-#define const_fieldKindIface 0x2
-#define const_fieldKindEface 0x3
-#define const_tagEOF 0x0
-#define const_tagObject 0x1
-#define const_tagOtherRoot 0x2
-#define const_tagType 0x3
-#define const_tagGoroutine 0x4
-#define const_tagStackFrame 0x5
-#define const_tagParams 0x6
-#define const_tagFinalizer 0x7
-#define const_tagItab 0x8
-#define const_tagOSThread 0x9
-#define const_tagMemStats 0xa
-#define const_tagQueuedFinalizer 0xb
-#define const_tagData 0xc
-#define const_tagBSS 0xd
-#define const_tagDefer 0xe
-#define const_tagPanic 0xf
-#define const_tagMemProf 0x10
-#define const_tagAllocSample 0x11
-#define const_bufSize 0x1000
-#define const_typeCacheBuckets 0x100
-#define const_typeCacheAssoc 0x4
-#define typeCacheBucket__size 32
-#define typeCacheBucket_t 0
-#define childInfo__size 48
-#define childInfo_argoff 0
-#define childInfo_arglen 8
-#define childInfo_args 16
-#define childInfo_sp 32
-#define childInfo_depth 40
-#define const_hashSize 0x3f1
-#define const_locked 0x1
-#define const_active_spin 0x4
-#define const_active_spin_cnt 0x1e
-#define const_passive_spin 0x1
-#define const_debugMalloc false
-#define const_flagNoScan 0x1
-#define const_flagNoZero 0x2
-#define const_maxTinySize 0x10
-#define const_tinySizeClass 0x2
-#define const_maxSmallSize 0x8000
-#define const_pageShift 0xd
-#define const_pageSize 0x2000
-#define const_pageMask 0x1fff
-#define const_mSpanInUse 0x0
-#define const_concurrentSweep true
-#define const__PageShift 0xd
-#define const__PageSize 0x2000
-#define const__PageMask 0x1fff
-#define const__64bit 0x1
-#define const__NumSizeClasses 0x43
-#define const__MaxSmallSize 0x8000
-#define const__TinySize 0x10
-#define const__TinySizeClass 0x2
-#define const__FixAllocChunk 0x4000
-#define const__MaxMHeapList 0x80
-#define const__HeapAllocChunk 0x100000
-#define const__StackCacheSize 0x8000
-#define const__NumStackOrders 0x2
-#define const__MHeapMap_TotalBits 0x23
-#define const__MHeapMap_Bits 0x16
-#define const__MaxMem 0x7ffffffff
-#define const__MaxGcproc 0x20
-#define const__MaxArena32 0x80000000
-#define const__FlagNoScan 0x1
-#define const__FlagNoZero 0x2
-#define persistentAlloc__size 16
-#define persistentAlloc_base 0
-#define persistentAlloc_off 8
-#define const_bitPointer 0x1
-#define const_bitMarked 0x10
-#define const_heapBitsShift 0x1
-#define const_heapBitmapScale 0x20
-#define const_bitMarkedAll 0xf0
-#define const_bitPointerAll 0xf
-#define heapBits__size 16
-#define heapBits_bitp 0
-#define heapBits_shift 8
-#define mcache__size 1168
-#define mcache_next_sample 0
-#define mcache_local_scan 8
-#define mcache_tiny 16
-#define mcache_tinyoffset 24
-#define mcache_local_tinyallocs 32
-#define mcache_alloc 40
-#define mcache_stackcache 576
-#define mcache_local_nlookup 608
-#define mcache_local_largefree 616
-#define mcache_local_nlargefree 624
-#define mcache_local_nsmallfree 632
-#define gclink__size 8
-#define gclink_next 0
-#define stackfreelist__size 16
-#define stackfreelist_list 0
-#define stackfreelist_size 8
-#define mcentral__size 48
-#define mcentral_lock 0
-#define mcentral_sizeclass 8
-#define mcentral_nonempty 16
-#define mcentral_empty 32
-#define const__MEM_COMMIT 0x1000
-#define const__MEM_RESERVE 0x2000
-#define const__MEM_DECOMMIT 0x4000
-#define const__MEM_RELEASE 0x8000
-#define const__PAGE_READWRITE 0x4
-#define const__PAGE_NOACCESS 0x1
-#define finblock__size 4064
-#define finblock_alllink 0
-#define finblock_next 8
-#define finblock_cnt 16
-#define finblock_fin 24
-#define finalizer__size 40
-#define finalizer_fn 0
-#define finalizer_arg 8
-#define finalizer_nret 16
-#define finalizer_fint 24
-#define finalizer_ot 32
-#define fixalloc__size 64
-#define fixalloc_size 0
-#define fixalloc_first 8
-#define fixalloc_arg 16
-#define fixalloc_list 24
-#define fixalloc_chunk 32
-#define fixalloc_nchunk 40
-#define fixalloc_inuse 48
-#define fixalloc_stat 56
-#define mlink__size 8
-#define mlink_next 0
-#define const__DebugGC 0x0
-#define const__ConcurrentSweep true
-#define const__FinBlockSize 0x1000
-#define const_sweepMinHeapDistance 0x100000
-#define const_defaultHeapMinimum 0x400000
-#define const__GCoff 0x0
-#define const__GCmark 0x1
-#define const__GCmarktermination 0x2
-#define const_gcMarkWorkerDedicatedMode 0x0
-#define const_gcMarkWorkerFractionalMode 0x1
-#define const_gcMarkWorkerIdleMode 0x2
-#define gcControllerState__size 248
-#define gcControllerState_scanWork 0
-#define gcControllerState_bgScanCredit 8
-#define gcControllerState_assistTime 16
-#define gcControllerState_dedicatedMarkTime 24
-#define gcControllerState_fractionalMarkTime 32
-#define gcControllerState_idleMarkTime 40
-#define gcControllerState_bgMarkStartTime 48
-#define gcControllerState_assistStartTime 56
-#define gcControllerState_heapGoal 64
-#define gcControllerState_dedicatedMarkWorkersNeeded 72
-#define gcControllerState_assistWorkPerByte 80
-#define gcControllerState_assistBytesPerWork 88
-#define gcControllerState_fractionalUtilizationGoal 96
-#define gcControllerState_triggerRatio 104
-#define gcControllerState_fractionalMarkWorkersNeeded 176
-#define const_gcGoalUtilization 6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042048p-513
-#define const_gcCreditSlack 0x7d0
-#define const_gcAssistTimeSlack 0x1388
-#define const_gcOverAssistBytes 0x100000
-#define const_gcBackgroundMode 0x0
-#define const_gcForceMode 0x1
-#define const_gcForceBlockMode 0x2
-#define const_fixedRootFinalizers 0x0
-#define const_fixedRootFlushCaches 0x1
-#define const_fixedRootCount 0x2
-#define const_rootBlockBytes 0x40000
-#define const_rootBlockSpans 0x2000
-#define const_gcDrainUntilPreempt 0x1
-#define const_gcDrainNoBlock 0x2
-#define const_gcDrainFlushBgCredit 0x4
-#define const_gcDrainBlock 0x0
-#define sweepdata__size 32
-#define sweepdata_lock 0
-#define sweepdata_g 8
-#define sweepdata_parked 16
-#define sweepdata_started 17
-#define sweepdata_spanidx 20
-#define sweepdata_nbgsweep 24
-#define sweepdata_npausesweep 28
-#define const__Debugwbufs false
-#define const__WorkbufSize 0x800
-#define gcWork__size 32
-#define gcWork_wbuf1 0
-#define gcWork_wbuf2 8
-#define gcWork_bytesMarked 16
-#define gcWork_scanWork 24
-#define workbufhdr__size 64
-#define workbufhdr_node 0
-#define workbufhdr_nobj 16
-#define workbufhdr_inuse 24
-#define workbufhdr_log 32
-#define workbuf__size 2048
-#define workbuf_workbufhdr 0
-#define workbuf_obj 64
-#define mheap__size 12584
-#define mheap_lock 0
-#define mheap_free 8
-#define mheap_freelarge 2056
-#define mheap_busy 2072
-#define mheap_busylarge 4120
-#define mheap_allspans 4136
-#define mheap_gcspans 4144
-#define mheap_nspan 4152
-#define mheap_sweepgen 4156
-#define mheap_sweepdone 4160
-#define mheap_spans 4168
-#define mheap_spans_mapped 4176
-#define mheap_pagesInUse 4184
-#define mheap_spanBytesAlloc 4192
-#define mheap_pagesSwept 4200
-#define mheap_sweepPagesPerByte 4208
-#define mheap_largefree 4216
-#define mheap_nlargefree 4224
-#define mheap_nsmallfree 4232
-#define mheap_bitmap 4768
-#define mheap_bitmap_mapped 4776
-#define mheap_arena_start 4784
-#define mheap_arena_used 4792
-#define mheap_arena_end 4800
-#define mheap_arena_reserved 4808
-#define mheap_central 4816
-#define mheap_spanalloc 12320
-#define mheap_cachealloc 12384
-#define mheap_specialfinalizeralloc 12448
-#define mheap_specialprofilealloc 12512
-#define mheap_speciallock 12576
-#define const__MSpanInUse 0x0
-#define const__MSpanStack 0x1
-#define const__MSpanFree 0x2
-#define const__MSpanDead 0x3
-#define mSpanList__size 16
-#define mSpanList_first 0
-#define mSpanList_last 8
-#define mspan__size 120
-#define mspan_next 0
-#define mspan_prev 8
-#define mspan_list 16
-#define mspan_start 24
-#define mspan_npages 32
-#define mspan_freelist 40
-#define mspan_sweepgen 48
-#define mspan_divMul 52
-#define mspan_ref 56
-#define mspan_sizeclass 58
-#define mspan_incache 59
-#define mspan_state 60
-#define mspan_needzero 61
-#define mspan_divShift 62
-#define mspan_divShift2 63
-#define mspan_elemsize 64
-#define mspan_unusedsince 72
-#define mspan_npreleased 80
-#define mspan_limit 88
-#define mspan_speciallock 96
-#define mspan_specials 104
-#define mspan_baseMask 112
-#define const__KindSpecialFinalizer 0x1
-#define const__KindSpecialProfile 0x2
-#define special__size 16
-#define special_next 0
-#define special_offset 8
-#define special_kind 10
-#define specialfinalizer__size 48
-#define specialfinalizer_special 0
-#define specialfinalizer_fn 16
-#define specialfinalizer_nret 24
-#define specialfinalizer_fint 32
-#define specialfinalizer_ot 40
-#define specialprofile__size 24
-#define specialprofile_special 0
-#define specialprofile_b 16
-#define const_memProfile 0x1
-#define const_blockProfile 0x2
-#define const_buckHashSize 0x2bf1f
-#define const_maxStack 0x20
-#define bucket__size 48
-#define bucket_next 0
-#define bucket_allnext 8
-#define bucket_typ 16
-#define bucket_hash 24
-#define bucket_size 32
-#define bucket_nstk 40
-#define memRecord__size 96
-#define memRecord_allocs 0
-#define memRecord_frees 8
-#define memRecord_alloc_bytes 16
-#define memRecord_free_bytes 24
-#define memRecord_prev_allocs 32
-#define memRecord_prev_frees 40
-#define memRecord_prev_alloc_bytes 48
-#define memRecord_prev_free_bytes 56
-#define memRecord_recent_allocs 64
-#define memRecord_recent_frees 72
-#define memRecord_recent_alloc_bytes 80
-#define memRecord_recent_free_bytes 88
-#define blockRecord__size 16
-#define blockRecord_count 0
-#define blockRecord_cycles 8
-#define StackRecord__size 256
-#define StackRecord_Stack0 0
-#define MemProfileRecord__size 288
-#define MemProfileRecord_AllocBytes 0
-#define MemProfileRecord_FreeBytes 8
-#define MemProfileRecord_AllocObjects 16
-#define MemProfileRecord_FreeObjects 24
-#define MemProfileRecord_Stack0 32
-#define BlockProfileRecord__size 272
-#define BlockProfileRecord_Count 0
-#define BlockProfileRecord_Cycles 8
-#define BlockProfileRecord_StackRecord 16
-#define const_msanenabled false
-#define divMagic__size 24
-#define divMagic_shift 0
-#define divMagic_mul 4
-#define divMagic_shift2 8
-#define divMagic_baseMask 16
-#define mstats__size 5960
-#define mstats_alloc 0
-#define mstats_total_alloc 8
-#define mstats_sys 16
-#define mstats_nlookup 24
-#define mstats_nmalloc 32
-#define mstats_nfree 40
-#define mstats_heap_alloc 48
-#define mstats_heap_sys 56
-#define mstats_heap_idle 64
-#define mstats_heap_inuse 72
-#define mstats_heap_released 80
-#define mstats_heap_objects 88
-#define mstats_stacks_inuse 96
-#define mstats_stacks_sys 104
-#define mstats_mspan_inuse 112
-#define mstats_mspan_sys 120
-#define mstats_mcache_inuse 128
-#define mstats_mcache_sys 136
-#define mstats_buckhash_sys 144
-#define mstats_gc_sys 152
-#define mstats_other_sys 160
-#define mstats_next_gc 168
-#define mstats_last_gc 176
-#define mstats_pause_total_ns 184
-#define mstats_pause_ns 192
-#define mstats_pause_end 2240
-#define mstats_numgc 4288
-#define mstats_gc_cpu_fraction 4296
-#define mstats_enablegc 4304
-#define mstats_debuggc 4305
-#define mstats_by_size 4312
-#define mstats_tinyallocs 5920
-#define mstats_heap_live 5928
-#define mstats_heap_scan 5936
-#define mstats_heap_marked 5944
-#define mstats_heap_reachable 5952
-#define MemStats__size 5776
-#define MemStats_Alloc 0
-#define MemStats_TotalAlloc 8
-#define MemStats_Sys 16
-#define MemStats_Lookups 24
-#define MemStats_Mallocs 32
-#define MemStats_Frees 40
-#define MemStats_HeapAlloc 48
-#define MemStats_HeapSys 56
-#define MemStats_HeapIdle 64
-#define MemStats_HeapInuse 72
-#define MemStats_HeapReleased 80
-#define MemStats_HeapObjects 88
-#define MemStats_StackInuse 96
-#define MemStats_StackSys 104
-#define MemStats_MSpanInuse 112
-#define MemStats_MSpanSys 120
-#define MemStats_MCacheInuse 128
-#define MemStats_MCacheSys 136
-#define MemStats_BuckHashSys 144
-#define MemStats_GCSys 152
-#define MemStats_OtherSys 160
-#define MemStats_NextGC 168
-#define MemStats_LastGC 176
-#define MemStats_PauseTotalNs 184
-#define MemStats_PauseNs 192
-#define MemStats_PauseEnd 2240
-#define MemStats_NumGC 4288
-#define MemStats_GCCPUFraction 4296
-#define MemStats_EnableGC 4304
-#define MemStats_DebugGC 4305
-#define MemStats_BySize 4312
-#define const_debugStackBarrier false
-#define const_pdReady 0x1
-#define const_pdWait 0x2
-#define const_pollBlockSize 0x1000
-#define pollDesc__size 192
-#define pollDesc_link 0
-#define pollDesc_lock 8
-#define pollDesc_fd 16
-#define pollDesc_closing 24
-#define pollDesc_seq 32
-#define pollDesc_rg 40
-#define pollDesc_rt 48
-#define pollDesc_rd 104
-#define pollDesc_wg 112
-#define pollDesc_wt 120
-#define pollDesc_wd 176
-#define pollDesc_user 184
-#define pollCache__size 16
-#define pollCache_lock 0
-#define pollCache_first 8
-#define const__DWORD_MAX 0xffffffff
-#define const__INVALID_HANDLE_VALUE 0xffffffffffffffff
-#define net_op__size 56
-#define net_op_o 0
-#define net_op_pd 32
-#define net_op_mode 40
-#define net_op_errno 44
-#define net_op_qty 48
-#define overlappedEntry__size 32
-#define overlappedEntry_key 0
-#define overlappedEntry_op 8
-#define overlappedEntry_internal 16
-#define overlappedEntry_qty 24
-#define sigset__size 0
-#define const_currentProcess 0xffffffffffffffff
-#define const_currentThread 0xfffffffffffffffe
-#define _KSYSTEM_TIME__size 12
-#define _KSYSTEM_TIME_LowPart 0
-#define _KSYSTEM_TIME_High1Time 4
-#define _KSYSTEM_TIME_High2Time 8
-#define const__INTERRUPT_TIME 0x7ffe0008
-#define const__SYSTEM_TIME 0x7ffe0014
-#define const__NSIG 0x41
-#define mOS__size 8
-#define mOS_waitsema 0
-#define const_deferHeaderSize 0x30
-#define const_minDeferAlloc 0x30
-#define const_minDeferArgs 0x0
-#define parfor__size 96
-#define parfor_body 0
-#define parfor_done 8
-#define parfor_nthr 12
-#define parfor_thrseq 16
-#define parfor_cnt 20
-#define parfor_wait 24
-#define parfor_thr 32
-#define parfor_nsteal 56
-#define parfor_nstealcnt 64
-#define parfor_nprocyield 72
-#define parfor_nosyield 80
-#define parfor_nsleep 88
-#define parforthread__size 112
-#define parforthread_pos 0
-#define parforthread_nsteal 8
-#define parforthread_nstealcnt 16
-#define parforthread_nprocyield 24
-#define parforthread_nosyield 32
-#define parforthread_nsleep 40
-#define parforthread_pad 48
-#define const__GoidCacheBatch 0x10
-#define const_freezeStopWait 0x7fffffff
-#define cgothreadstart__size 24
-#define cgothreadstart_g 0
-#define cgothreadstart_tls 8
-#define cgothreadstart_fn 16
-#define const_forcePreemptNS 0x989680
-#define const_randomizeScheduler false
-#define const_raceenabled false
-#define const_bit1 0x7
-#define const_bitx 0x6
-#define const_bit2 0x5
-#define const_bit3 0x4
-#define const_bit4 0x3
-#define const_bit5 0x2
-#define const_t1 0x0
-#define const_tx 0x80
-#define const_t2 0xc0
-#define const_t3 0xe0
-#define const_t4 0xf0
-#define const_t5 0xf8
-#define const_rune1 0x7f
-#define const_rune2 0x7ff
-#define const_rune3 0xffff
-#define const_rune4 0x1fffff
-#define const_maskx 0x3f
-#define const_testx 0xc0
-#define const_runeerror 0xfffd
-#define const_runeself 0x80
-#define const_surrogateMin 0xd800
-#define const_surrogateMax 0xdfff
-#define const_bad 0xfffd
-#define const_runemax 0x10ffff
-#define const_tracebackCrash 0x1
-#define const_tracebackAll 0x2
-#define const_tracebackShift 0x2
-#define dbgVar__size 24
-#define dbgVar_name 0
-#define dbgVar_value 16
-#define const__Gidle 0x0
-#define const__Grunnable 0x1
-#define const__Grunning 0x2
-#define const__Gsyscall 0x3
-#define const__Gwaiting 0x4
-#define const__Gmoribund_unused 0x5
-#define const__Gdead 0x6
-#define const__Genqueue 0x7
-#define const__Gcopystack 0x8
-#define const__Gscan 0x1000
-#define const__Gscanrunnable 0x1001
-#define const__Gscanrunning 0x1002
-#define const__Gscansyscall 0x1003
-#define const__Gscanwaiting 0x1004
-#define const__Gscanenqueue 0x1007
-#define const__Pidle 0x0
-#define const__Prunning 0x1
-#define const__Psyscall 0x2
-#define const__Pgcstop 0x3
-#define const__Pdead 0x4
-#define mutex__size 8
-#define mutex_key 0
-#define note__size 8
-#define note_key 0
-#define funcval__size 8
-#define funcval_fn 0
-#define iface__size 16
-#define iface_tab 0
-#define iface_data 8
-#define eface__size 16
-#define eface__type 0
-#define eface_data 8
-#define gobuf__size 56
-#define gobuf_sp 0
-#define gobuf_pc 8
-#define gobuf_g 16
-#define gobuf_ctxt 24
-#define gobuf_ret 32
-#define gobuf_lr 40
-#define gobuf_bp 48
-#define sudog__size 64
-#define sudog_g 0
-#define sudog_selectdone 8
-#define sudog_next 16
-#define sudog_prev 24
-#define sudog_elem 32
-#define sudog_releasetime 40
-#define sudog_nrelease 48
-#define sudog_waitlink 56
-#define gcstats__size 40
-#define gcstats_nhandoff 0
-#define gcstats_nhandoffcnt 8
-#define gcstats_nprocyield 16
-#define gcstats_nosyield 24
-#define gcstats_nsleep 32
-#define libcall__size 48
-#define libcall_fn 0
-#define libcall_n 8
-#define libcall_args 16
-#define libcall_r1 24
-#define libcall_r2 32
-#define libcall_err 40
-#define wincallbackcontext__size 32
-#define wincallbackcontext_gobody 0
-#define wincallbackcontext_argsize 8
-#define wincallbackcontext_restorestack 16
-#define wincallbackcontext_cleanstack 24
-#define stack__size 16
-#define stack_lo 0
-#define stack_hi 8
-#define stkbar__size 16
-#define stkbar_savedLRPtr 0
-#define stkbar_savedLRVal 8
-#define g__size 360
-#define g_stack 0
-#define g_stackguard0 16
-#define g_stackguard1 24
-#define g__panic 32
-#define g__defer 40
-#define g_m 48
-#define g_stackAlloc 56
-#define g_sched 64
-#define g_syscallsp 120
-#define g_syscallpc 128
-#define g_stkbar 136
-#define g_stkbarPos 160
-#define g_stktopsp 168
-#define g_param 176
-#define g_atomicstatus 184
-#define g_stackLock 188
-#define g_goid 192
-#define g_waitsince 200
-#define g_waitreason 208
-#define g_schedlink 224
-#define g_preempt 232
-#define g_paniconfault 233
-#define g_preemptscan 234
-#define g_gcscandone 235
-#define g_gcscanvalid 236
-#define g_throwsplit 237
-#define g_raceignore 238
-#define g_sysblocktraced 239
-#define g_sysexitticks 240
-#define g_sysexitseq 248
-#define g_lockedm 256
-#define g_sig 264
-#define g_writebuf 272
-#define g_sigcode0 296
-#define g_sigcode1 304
-#define g_sigpc 312
-#define g_gopc 320
-#define g_startpc 328
-#define g_racectx 336
-#define g_waiting 344
-#define g_gcAssistBytes 352
-#define m__size 928
-#define m_g0 0
-#define m_morebuf 8
-#define m_divmod 64
-#define m_procid 72
-#define m_gsignal 80
-#define m_sigmask 88
-#define m_tls 88
-#define m_mstartfn 136
-#define m_curg 144
-#define m_caughtsig 152
-#define m_p 160
-#define m_nextp 168
-#define m_id 176
-#define m_mallocing 180
-#define m_throwing 184
-#define m_preemptoff 192
-#define m_locks 208
-#define m_softfloat 212
-#define m_dying 216
-#define m_profilehz 220
-#define m_helpgc 224
-#define m_spinning 228
-#define m_blocked 229
-#define m_inwb 230
-#define m_newSigstack 231
-#define m_printlock 232
-#define m_fastrand 236
-#define m_ncgocall 240
-#define m_ncgo 248
-#define m_park 256
-#define m_alllink 264
-#define m_schedlink 272
-#define m_machport 280
-#define m_mcache 288
-#define m_lockedg 296
-#define m_createstack 304
-#define m_freglo 560
-#define m_freghi 624
-#define m_fflag 688
-#define m_locked 692
-#define m_nextwaitm 696
-#define m_gcstats 704
-#define m_needextram 744
-#define m_traceback 745
-#define m_waitunlockf 752
-#define m_waitlock 760
-#define m_waittraceev 768
-#define m_waittraceskip 776
-#define m_startingtrace 784
-#define m_syscalltick 788
-#define m_thread 792
-#define m_libcall 800
-#define m_libcallpc 848
-#define m_libcallsp 856
-#define m_libcallg 864
-#define m_syscall 872
-#define m_mOS 920
-#define p__size 4744
-#define p_lock 0
-#define p_id 8
-#define p_status 12
-#define p_link 16
-#define p_schedtick 24
-#define p_syscalltick 28
-#define p_m 32
-#define p_mcache 40
-#define p_deferpool 48
-#define p_deferpoolbuf 168
-#define p_goidcache 1448
-#define p_goidcacheend 1456
-#define p_runqhead 1464
-#define p_runqtail 1468
-#define p_runq 1472
-#define p_runnext 3520
-#define p_gfree 3528
-#define p_gfreecnt 3536
-#define p_sudogcache 3544
-#define p_sudogbuf 3568
-#define p_tracebuf 4592
-#define p_palloc 4600
-#define p_gcAssistTime 4616
-#define p_gcBgMarkWorker 4624
-#define p_gcMarkWorkerMode 4632
-#define p_gcw 4640
-#define p_runSafePointFn 4672
-#define p_pad 4676
-#define const__MaxGomaxprocs 0x100
-#define schedt__size 264
-#define schedt_goidgen 0
-#define schedt_lastpoll 8
-#define schedt_lock 16
-#define schedt_midle 24
-#define schedt_nmidle 32
-#define schedt_nmidlelocked 36
-#define schedt_mcount 40
-#define schedt_maxmcount 44
-#define schedt_ngsys 48
-#define schedt_pidle 56
-#define schedt_npidle 64
-#define schedt_nmspinning 68
-#define schedt_runqhead 72
-#define schedt_runqtail 80
-#define schedt_runqsize 88
-#define schedt_gflock 96
-#define schedt_gfree 104
-#define schedt_ngfree 112
-#define schedt_sudoglock 120
-#define schedt_sudogcache 128
-#define schedt_deferlock 136
-#define schedt_deferpool 144
-#define schedt_gcwaiting 184
-#define schedt_stopwait 188
-#define schedt_stopnote 192
-#define schedt_sysmonwait 200
-#define schedt_sysmonnote 208
-#define schedt_safePointFn 216
-#define schedt_safePointWait 224
-#define schedt_safePointNote 232
-#define schedt_profilehz 240
-#define schedt_procresizetime 248
-#define schedt_totaltime 256
-#define const__LockExternal 0x1
-#define const__LockInternal 0x2
-#define sigtabtt__size 16
-#define sigtabtt_flags 0
-#define sigtabtt_name 8
-#define const__SigNotify 0x1
-#define const__SigKill 0x2
-#define const__SigThrow 0x4
-#define const__SigPanic 0x8
-#define const__SigDefault 0x10
-#define const__SigHandling 0x20
-#define const__SigGoExit 0x40
-#define const__SigSetStack 0x80
-#define const__SigUnblock 0x100
-#define _func__size 40
-#define _func_entry 0
-#define _func_nameoff 8
-#define _func_args 12
-#define _func_pcsp 20
-#define _func_pcfile 24
-#define _func_pcln 28
-#define _func_npcdata 32
-#define _func_nfuncdata 36
-#define itab__size 40
-#define itab_inter 0
-#define itab__type 8
-#define itab_link 16
-#define itab_bad 24
-#define itab_unused 28
-#define itab_fun 32
-#define lfnode__size 16
-#define lfnode_next 0
-#define lfnode_pushcnt 8
-#define forcegcstate__size 24
-#define forcegcstate_lock 0
-#define forcegcstate_g 8
-#define forcegcstate_idle 16
-#define const__Structrnd 0x8
-#define _defer__size 48
-#define _defer_siz 0
-#define _defer_started 4
-#define _defer_sp 8
-#define _defer_pc 16
-#define _defer_fn 24
-#define _defer__panic 32
-#define _defer_link 40
-#define _panic__size 40
-#define _panic_argp 0
-#define _panic_arg 8
-#define _panic_link 24
-#define _panic_recovered 32
-#define _panic_aborted 33
-#define stkframe__size 80
-#define stkframe_fn 0
-#define stkframe_pc 8
-#define stkframe_continpc 16
-#define stkframe_lr 24
-#define stkframe_sp 32
-#define stkframe_fp 40
-#define stkframe_varp 48
-#define stkframe_argp 56
-#define stkframe_arglen 64
-#define stkframe_argmap 72
-#define const__TraceRuntimeFrames 0x1
-#define const__TraceTrap 0x2
-#define const__TraceJumpStack 0x4
-#define const__TracebackMaxFrames 0x64
-#define const_debugSelect false
-#define const_caseRecv 0x1
-#define const_caseSend 0x2
-#define const_caseDefault 0x3
-#define hselect__size 72
-#define hselect_tcase 0
-#define hselect_ncase 2
-#define hselect_pollorder 8
-#define hselect_lockorder 16
-#define hselect_scase 24
-#define scase__size 48
-#define scase_elem 0
-#define scase_c 8
-#define scase_pc 16
-#define scase_kind 24
-#define scase_so 26
-#define scase_receivedp 32
-#define scase_releasetime 40
-#define runtimeSelect__size 32
-#define runtimeSelect_dir 0
-#define runtimeSelect_typ 8
-#define runtimeSelect_ch 16
-#define runtimeSelect_val 24
-#define const_selectSend 0x1
-#define const_selectRecv 0x2
-#define const_selectDefault 0x3
-#define semaRoot__size 32
-#define semaRoot_lock 0
-#define semaRoot_head 8
-#define semaRoot_tail 16
-#define semaRoot_nwait 24
-#define const_semTabSize 0xfb
-#define syncSema__size 24
-#define syncSema_lock 0
-#define syncSema_head 8
-#define syncSema_tail 16
-#define const_sigIdle 0x0
-#define const_sigReceiving 0x1
-#define const_sigSending 0x2
-#define slice__size 24
-#define slice_array 0
-#define slice_len 8
-#define slice_cap 16
-#define const_mantbits64 0x34
-#define const_expbits64 0xb
-#define const_bias64 -0x3ff
-#define const_nan64 0x7ff0000000000001
-#define const_inf64 0x7ff0000000000000
-#define const_neg64 0x8000000000000000
-#define const_mantbits32 0x17
-#define const_expbits32 0x8
-#define const_bias32 -0x7f
-#define const_nan32 0x7f800001
-#define const_inf32 0x7f800000
-#define const_neg32 0x80000000
-#define const_float64Mask 0x7ff
-#define const_float64Shift 0x34
-#define const_float64Bias 0x3ff
-#define const_float64NaN 0x7ff8000000000001
-#define const_float64Inf 0x7ff0000000000000
-#define const_maxFloat64 13407807929942595611008317640802934282466402440167209614001403565979142097391741634560602662607475277315483922957140688461601785037921060160934708985602772p+512
-#define const__StackSystem 0x1000
-#define const__StackMin 0x800
-#define const__FixedStack0 0x1800
-#define const__FixedStack1 0x17ff
-#define const__FixedStack2 0x1fff
-#define const__FixedStack3 0x1fff
-#define const__FixedStack4 0x1fff
-#define const__FixedStack5 0x1fff
-#define const__FixedStack6 0x1fff
-#define const__FixedStack 0x2000
-#define const__StackBig 0x1000
-#define const__StackGuard 0x12d0
-#define const__StackSmall 0x80
-#define const__StackLimit 0x250
-#define const__StackPreempt 0xfffffffffffffade
-#define const__StackFork 0xfffffffffffffb2e
-#define const_stackDebug 0x0
-#define const_stackFromSystem 0x0
-#define const_stackFaultOnFree 0x0
-#define const_stackPoisonCopy 0x0
-#define const_stackCache 0x1
-#define const_uintptrMask 0xffffffffffffffff
-#define const_poisonStack 0x6868686868686868
-#define const_stackPreempt 0xfffffffffffffade
-#define const_stackFork 0xfffffffffffffb2e
-#define adjustinfo__size 280
-#define adjustinfo_old 0
-#define adjustinfo_delta 16
-#define adjustinfo_cache 24
-#define bitvector__size 16
-#define bitvector_n 0
-#define bitvector_bytedata 8
-#define gobitvector__size 32
-#define gobitvector_n 0
-#define gobitvector_bytedata 8
-#define const_tmpStringBufSize 0x20
-#define stringStruct__size 16
-#define stringStruct_str 0
-#define stringStruct_len 8
-#define stringStructDWARF__size 16
-#define stringStructDWARF_str 0
-#define stringStructDWARF_len 8
-#define neverCallThisFunction__size 0
-#define const__NoArgs 0xffffffffffffffff
-#define Func__size 0
-#define Func_opaque 0
-#define const__PCDATA_StackMapIndex 0x0
-#define const__FUNCDATA_ArgsPointerMaps 0x0
-#define const__FUNCDATA_LocalsPointerMaps 0x1
-#define const__ArgsSizeUnknown -0x80000000
-#define moduledata__size 304
-#define moduledata_pclntable 0
-#define moduledata_ftab 24
-#define moduledata_filetab 48
-#define moduledata_findfunctab 72
-#define moduledata_minpc 80
-#define moduledata_maxpc 88
-#define moduledata_text 96
-#define moduledata_etext 104
-#define moduledata_noptrdata 112
-#define moduledata_enoptrdata 120
-#define moduledata_data 128
-#define moduledata_edata 136
-#define moduledata_bss 144
-#define moduledata_ebss 152
-#define moduledata_noptrbss 160
-#define moduledata_enoptrbss 168
-#define moduledata_end 176
-#define moduledata_gcdata 184
-#define moduledata_gcbss 192
-#define moduledata_typelinks 200
-#define moduledata_modulename 224
-#define moduledata_modulehashes 240
-#define moduledata_gcdatamask 264
-#define moduledata_gcbssmask 280
-#define moduledata_next 296
-#define modulehash__size 40
-#define modulehash_modulename 0
-#define modulehash_linktimehash 16
-#define modulehash_runtimehash 32
-#define functab__size 16
-#define functab_entry 0
-#define functab_funcoff 8
-#define const_minfunc 0x10
-#define const_pcbucketsize 0x1000
-#define findfuncbucket__size 20
-#define findfuncbucket_idx 0
-#define findfuncbucket_subbuckets 4
-#define const_debugPcln false
-#define pcvalueCache__size 256
-#define pcvalueCache_entries 0
-#define pcvalueCacheEnt__size 16
-#define pcvalueCacheEnt_targetpc 0
-#define pcvalueCacheEnt_off 8
-#define pcvalueCacheEnt_val 12
-#define stackmap__size 12
-#define stackmap_n 0
-#define stackmap_nbit 4
-#define stackmap_bytedata 8
-#define callbacks__size 16016
-#define callbacks_lock 0
-#define callbacks_ctxt 8
-#define callbacks_n 16008
-#define timer__size 56
-#define timer_i 0
-#define timer_when 8
-#define timer_period 16
-#define timer_f 24
-#define timer_arg 32
-#define timer_seq 48
-#define const_traceEvNone 0x0
-#define const_traceEvBatch 0x1
-#define const_traceEvFrequency 0x2
-#define const_traceEvStack 0x3
-#define const_traceEvGomaxprocs 0x4
-#define const_traceEvProcStart 0x5
-#define const_traceEvProcStop 0x6
-#define const_traceEvGCStart 0x7
-#define const_traceEvGCDone 0x8
-#define const_traceEvGCScanStart 0x9
-#define const_traceEvGCScanDone 0xa
-#define const_traceEvGCSweepStart 0xb
-#define const_traceEvGCSweepDone 0xc
-#define const_traceEvGoCreate 0xd
-#define const_traceEvGoStart 0xe
-#define const_traceEvGoEnd 0xf
-#define const_traceEvGoStop 0x10
-#define const_traceEvGoSched 0x11
-#define const_traceEvGoPreempt 0x12
-#define const_traceEvGoSleep 0x13
-#define const_traceEvGoBlock 0x14
-#define const_traceEvGoUnblock 0x15
-#define const_traceEvGoBlockSend 0x16
-#define const_traceEvGoBlockRecv 0x17
-#define const_traceEvGoBlockSelect 0x18
-#define const_traceEvGoBlockSync 0x19
-#define const_traceEvGoBlockCond 0x1a
-#define const_traceEvGoBlockNet 0x1b
-#define const_traceEvGoSysCall 0x1c
-#define const_traceEvGoSysExit 0x1d
-#define const_traceEvGoSysBlock 0x1e
-#define const_traceEvGoWaiting 0x1f
-#define const_traceEvGoInSyscall 0x20
-#define const_traceEvHeapAlloc 0x21
-#define const_traceEvNextGC 0x22
-#define const_traceEvTimerGoroutine 0x23
-#define const_traceEvFutileWakeup 0x24
-#define const_traceEvCount 0x25
-#define const_traceTickDiv 0x40
-#define const_traceStackSize 0x80
-#define const_traceGlobProc -0x1
-#define const_traceBytesPerNumber 0xa
-#define const_traceArgCountShift 0x6
-#define const_traceFutileWakeup 0x80
-#define traceBufHeader__size 1056
-#define traceBufHeader_link 0
-#define traceBufHeader_lastSeq 8
-#define traceBufHeader_lastTicks 16
-#define traceBufHeader_pos 24
-#define traceBufHeader_stk 32
-#define traceBuf__size 65536
-#define traceBuf_traceBufHeader 0
-#define traceBuf_arr 1056
-#define traceStackTable__size 65568
-#define traceStackTable_lock 0
-#define traceStackTable_seq 8
-#define traceStackTable_mem 16
-#define traceStackTable_tab 32
-#define traceStack__size 40
-#define traceStack_link 0
-#define traceStack_hash 8
-#define traceStack_id 16
-#define traceStack_n 24
-#define traceStack_stk 32
-#define traceAlloc__size 16
-#define traceAlloc_head 0
-#define traceAlloc_off 8
-#define traceAllocBlock__size 65536
-#define traceAllocBlock_next 0
-#define traceAllocBlock_data 8
-#define const_usesLR false
-#define _type__size 64
-#define _type_size 0
-#define _type_ptrdata 8
-#define _type_hash 16
-#define _type__unused 20
-#define _type_align 21
-#define _type_fieldalign 22
-#define _type_kind 23
-#define _type_alg 24
-#define _type_gcdata 32
-#define _type__string 40
-#define _type_x 48
-#define _type_ptrto 56
-#define method__size 48
-#define method_name 0
-#define method_pkgpath 8
-#define method_mtyp 16
-#define method_typ 24
-#define method_ifn 32
-#define method_tfn 40
-#define uncommontype__size 40
-#define uncommontype_name 0
-#define uncommontype_pkgpath 8
-#define uncommontype_mhdr 16
-#define imethod__size 24
-#define imethod_name 0
-#define imethod_pkgpath 8
-#define imethod__type 16
-#define interfacetype__size 88
-#define interfacetype_typ 0
-#define interfacetype_mhdr 64
-#define maptype__size 104
-#define maptype_typ 0
-#define maptype_key 64
-#define maptype_elem 72
-#define maptype_bucket 80
-#define maptype_hmap 88
-#define maptype_keysize 96
-#define maptype_indirectkey 97
-#define maptype_valuesize 98
-#define maptype_indirectvalue 99
-#define maptype_bucketsize 100
-#define maptype_reflexivekey 102
-#define maptype_needkeyupdate 103
-#define arraytype__size 88
-#define arraytype_typ 0
-#define arraytype_elem 64
-#define arraytype_slice 72
-#define arraytype_len 80
-#define chantype__size 80
-#define chantype_typ 0
-#define chantype_elem 64
-#define chantype_dir 72
-#define slicetype__size 72
-#define slicetype_typ 0
-#define slicetype_elem 64
-#define functype__size 120
-#define functype_typ 0
-#define functype_dotdotdot 64
-#define functype_in 72
-#define functype_out 96
-#define ptrtype__size 72
-#define ptrtype_typ 0
-#define ptrtype_elem 64
-#define structfield__size 40
-#define structfield_name 0
-#define structfield_pkgpath 8
-#define structfield_typ 16
-#define structfield_tag 24
-#define structfield_offset 32
-#define structtype__size 88
-#define structtype_typ 0
-#define structtype_fields 64
-#define const_kindBool 0x1
-#define const_kindInt 0x2
-#define const_kindInt8 0x3
-#define const_kindInt16 0x4
-#define const_kindInt32 0x5
-#define const_kindInt64 0x6
-#define const_kindUint 0x7
-#define const_kindUint8 0x8
-#define const_kindUint16 0x9
-#define const_kindUint32 0xa
-#define const_kindUint64 0xb
-#define const_kindUintptr 0xc
-#define const_kindFloat32 0xd
-#define const_kindFloat64 0xe
-#define const_kindComplex64 0xf
-#define const_kindComplex128 0x10
-#define const_kindArray 0x11
-#define const_kindChan 0x12
-#define const_kindFunc 0x13
-#define const_kindInterface 0x14
-#define const_kindMap 0x15
-#define const_kindPtr 0x16
-#define const_kindSlice 0x17
-#define const_kindString 0x18
-#define const_kindStruct 0x19
-#define const_kindUnsafePointer 0x1a
-#define const_kindDirectIface 0x20
-#define const_kindGCProg 0x40
-#define const_kindNoPointers 0x80
-#define const_kindMask 0x1f
-#define const_cb_max 0x7d0
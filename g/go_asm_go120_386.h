@@ -0,0 +1,119 @@
+// Layout of the go1.20 runtime g and m, in the format of go tool compile -asmhdr.
+
+#define g__size 240
+#define g_stack 0
+#define g_stackguard0 8
+#define g_stackguard1 12
+#define g__panic 16
+#define g__defer 20
+#define g_m 24
+#define g_sched 28
+#define g_syscallsp 56
+#define g_syscallpc 60
+#define g_stktopsp 64
+#define g_param 68
+#define g_atomicstatus 72
+#define g_stackLock 76
+#define g_goid 80
+#define g_schedlink 88
+#define g_waitsince 92
+#define g_waitreason 100
+#define g_preempt 101
+#define g_preemptStop 102
+#define g_preemptShrink 103
+#define g_asyncSafePoint 104
+#define g_paniconfault 105
+#define g_gcscandone 106
+#define g_throwsplit 107
+#define g_activeStackChans 108
+#define g_parkingOnChan 109
+#define g_raceignore 110
+#define g_sysblocktraced 111
+#define g_tracking 112
+#define g_trackingSeq 113
+#define g_trackingStamp 116
+#define g_runnableTime 124
+#define g_sysexitticks 132
+#define g_traceseq 140
+#define g_tracelastp 148
+#define g_lockedm 152
+#define g_sig 156
+#define g_writebuf 160
+#define g_sigcode0 172
+#define g_sigcode1 176
+#define g_sigpc 180
+#define g_gopc 184
+#define g_ancestors 188
+#define g_startpc 192
+#define g_racectx 196
+#define g_waiting 200
+#define g_cgoCtxt 204
+#define g_labels 216
+#define g_timer 220
+#define g_selectDone 224
+#define g_goroutineProfiled 228
+#define g_gcAssistBytes 232
+#define m__size 560
+#define m_g0 0
+#define m_morebuf 4
+#define m_divmod 32
+#define m_procid 40
+#define m_gsignal 48
+#define m_goSigStack 52
+#define m_sigmask 72
+#define m_tls 80
+#define m_mstartfn 104
+#define m_curg 108
+#define m_caughtsig 112
+#define m_p 116
+#define m_nextp 120
+#define m_oldp 124
+#define m_id 128
+#define m_mallocing 136
+#define m_throwing 140
+#define m_preemptoff 144
+#define m_locks 152
+#define m_dying 156
+#define m_profilehz 160
+#define m_spinning 164
+#define m_blocked 165
+#define m_newSigstack 166
+#define m_printlock 167
+#define m_incgo 168
+#define m_isextra 169
+#define m_freeWait 172
+#define m_fastrand 176
+#define m_needextram 184
+#define m_traceback 185
+#define m_ncgocall 188
+#define m_ncgo 196
+#define m_cgoCallersUse 200
+#define m_cgoCallers 204
+#define m_park 208
+#define m_alllink 212
+#define m_schedlink 216
+#define m_lockedg 220
+#define m_createstack 224
+#define m_lockedExt 352
+#define m_lockedInt 356
+#define m_nextwaitm 360
+#define m_waitunlockf 364
+#define m_waitlock 368
+#define m_waittraceev 372
+#define m_waittraceskip 376
+#define m_startingtrace 380
+#define m_syscalltick 384
+#define m_freelink 388
+#define m_libcall 392
+#define m_libcallpc 416
+#define m_libcallsp 420
+#define m_libcallg 424
+#define m_syscall 428
+#define m_vdsoSP 452
+#define m_vdsoPC 456
+#define m_preemptGen 460
+#define m_signalPending 464
+#define m_dlogPerM 468
+#define m_mOS 468
+#define m_locksHeldLen 476
+#define m_locksHeld 480
@@ -0,0 +1,119 @@
+// Layout of the go1.20 runtime g and m, in the format of go tool compile -asmhdr.
+
+#define g__size 392
+#define g_stack 0
+#define g_stackguard0 16
+#define g_stackguard1 24
+#define g__panic 32
+#define g__defer 40
+#define g_m 48
+#define g_sched 56
+#define g_syscallsp 112
+#define g_syscallpc 120
+#define g_stktopsp 128
+#define g_param 136
+#define g_atomicstatus 144
+#define g_stackLock 148
+#define g_goid 152
+#define g_schedlink 160
+#define g_waitsince 168
+#define g_waitreason 176
+#define g_preempt 177
+#define g_preemptStop 178
+#define g_preemptShrink 179
+#define g_asyncSafePoint 180
+#define g_paniconfault 181
+#define g_gcscandone 182
+#define g_throwsplit 183
+#define g_activeStackChans 184
+#define g_parkingOnChan 185
+#define g_raceignore 186
+#define g_sysblocktraced 187
+#define g_tracking 188
+#define g_trackingSeq 189
+#define g_trackingStamp 192
+#define g_runnableTime 200
+#define g_sysexitticks 208
+#define g_traceseq 216
+#define g_tracelastp 224
+#define g_lockedm 232
+#define g_sig 240
+#define g_writebuf 248
+#define g_sigcode0 272
+#define g_sigcode1 280
+#define g_sigpc 288
+#define g_gopc 296
+#define g_ancestors 304
+#define g_startpc 312
+#define g_racectx 320
+#define g_waiting 328
+#define g_cgoCtxt 336
+#define g_labels 360
+#define g_timer 368
+#define g_selectDone 376
+#define g_goroutineProfiled 380
+#define g_gcAssistBytes 384
+#define m__size 1000
+#define m_g0 0
+#define m_morebuf 8
+#define m_divmod 64
+#define m_procid 72
+#define m_gsignal 80
+#define m_goSigStack 88
+#define m_sigmask 128
+#define m_tls 136
+#define m_mstartfn 184
+#define m_curg 192
+#define m_caughtsig 200
+#define m_p 208
+#define m_nextp 216
+#define m_oldp 224
+#define m_id 232
+#define m_mallocing 240
+#define m_throwing 244
+#define m_preemptoff 248
+#define m_locks 264
+#define m_dying 268
+#define m_profilehz 272
+#define m_spinning 276
+#define m_blocked 277
+#define m_newSigstack 278
+#define m_printlock 279
+#define m_incgo 280
+#define m_isextra 281
+#define m_freeWait 284
+#define m_fastrand 288
+#define m_needextram 296
+#define m_traceback 297
+#define m_ncgocall 304
+#define m_ncgo 312
+#define m_cgoCallersUse 316
+#define m_cgoCallers 320
+#define m_park 328
+#define m_alllink 336
+#define m_schedlink 344
+#define m_lockedg 352
+#define m_createstack 360
+#define m_lockedExt 616
+#define m_lockedInt 620
+#define m_nextwaitm 624
+#define m_waitunlockf 632
+#define m_waitlock 640
+#define m_waittraceev 648
+#define m_waittraceskip 656
+#define m_startingtrace 664
+#define m_syscalltick 668
+#define m_freelink 672
+#define m_libcall 680
+#define m_libcallpc 728
+#define m_libcallsp 736
+#define m_libcallg 744
+#define m_syscall 752
+#define m_vdsoSP 800
+#define m_vdsoPC 808
+#define m_preemptGen 816
+#define m_signalPending 820
+#define m_dlogPerM 824
+#define m_mOS 824
+#define m_locksHeldLen 832
+#define m_locksHeld 840
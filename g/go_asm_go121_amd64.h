@@ -0,0 +1,118 @@
+// Layout of the go1.21 runtime g and m, in the format of go tool compile -asmhdr.
+
+#define g__size 408
+#define g_stack 0
+#define g_stackguard0 16
+#define g_stackguard1 24
+#define g__panic 32
+#define g__defer 40
+#define g_m 48
+#define g_sched 56
+#define g_syscallsp 112
+#define g_syscallpc 120
+#define g_stktopsp 128
+#define g_param 136
+#define g_atomicstatus 144
+#define g_stackLock 148
+#define g_goid 152
+#define g_schedlink 160
+#define g_waitsince 168
+#define g_waitreason 176
+#define g_preempt 177
+#define g_preemptStop 178
+#define g_preemptShrink 179
+#define g_asyncSafePoint 180
+#define g_paniconfault 181
+#define g_gcscandone 182
+#define g_throwsplit 183
+#define g_activeStackChans 184
+#define g_parkingOnChan 185
+#define g_raceignore 186
+#define g_tracking 187
+#define g_trackingSeq 188
+#define g_trackingStamp 192
+#define g_runnableTime 200
+#define g_lockedm 208
+#define g_sig 216
+#define g_writebuf 224
+#define g_sigcode0 248
+#define g_sigcode1 256
+#define g_sigpc 264
+#define g_parentGoid 272
+#define g_gopc 280
+#define g_ancestors 288
+#define g_startpc 296
+#define g_racectx 304
+#define g_waiting 312
+#define g_cgoCtxt 320
+#define g_labels 344
+#define g_timer 352
+#define g_selectDone 360
+#define g_goroutineProfiled 364
+#define g_trace 368
+#define g_gcAssistBytes 400
+#define m__size 1008
+#define m_g0 0
+#define m_morebuf 8
+#define m_divmod 64
+#define m_procid 72
+#define m_gsignal 80
+#define m_goSigStack 88
+#define m_sigmask 128
+#define m_tls 136
+#define m_mstartfn 184
+#define m_curg 192
+#define m_caughtsig 200
+#define m_p 208
+#define m_nextp 216
+#define m_oldp 224
+#define m_id 232
+#define m_mallocing 240
+#define m_throwing 244
+#define m_preemptoff 248
+#define m_locks 264
+#define m_dying 268
+#define m_profilehz 272
+#define m_spinning 276
+#define m_blocked 277
+#define m_newSigstack 278
+#define m_printlock 279
+#define m_incgo 280
+#define m_isextra 281
+#define m_isExtraInC 282
+#define m_freeWait 284
+#define m_fastrand 288
+#define m_needextram 296
+#define m_traceback 297
+#define m_ncgocall 304
+#define m_ncgo 312
+#define m_cgoCallersUse 316
+#define m_cgoCallers 320
+#define m_park 328
+#define m_alllink 336
+#define m_schedlink 344
+#define m_lockedg 352
+#define m_createstack 360
+#define m_lockedExt 616
+#define m_lockedInt 620
+#define m_nextwaitm 624
+#define m_waitunlockf 632
+#define m_waitlock 640
+#define m_waitTraceBlockReason 648
+#define m_waitTraceSkip 656
+#define m_syscalltick 664
+#define m_freelink 672
+#define m_trace 680
+#define m_libcall 688
+#define m_libcallpc 736
+#define m_libcallsp 744
+#define m_libcallg 752
+#define m_syscall 760
+#define m_vdsoSP 808
+#define m_vdsoPC 816
+#define m_preemptGen 824
+#define m_signalPending 828
+#define m_dlogPerM 832
+#define m_mOS 832
+#define m_locksHeldLen 840
+#define m_locksHeld 848
@@ -0,0 +1,126 @@
+// Layout of the go1.22 runtime g and m, in the format of go tool compile -asmhdr.
+
+#define g__size 260
+#define g_stack 0
+#define g_stackguard0 8
+#define g_stackguard1 12
+#define g__panic 16
+#define g__defer 20
+#define g_m 24
+#define g_sched 28
+#define g_syscallsp 56
+#define g_syscallpc 60
+#define g_stktopsp 64
+#define g_param 68
+#define g_atomicstatus 72
+#define g_stackLock 76
+#define g_goid 80
+#define g_schedlink 88
+#define g_waitsince 92
+#define g_waitreason 100
+#define g_preempt 101
+#define g_preemptStop 102
+#define g_preemptShrink 103
+#define g_asyncSafePoint 104
+#define g_paniconfault 105
+#define g_gcscandone 106
+#define g_throwsplit 107
+#define g_activeStackChans 108
+#define g_parkingOnChan 109
+#define g_inMarkAssist 110
+#define g_coroexit 111
+#define g_raceignore 112
+#define g_nocgocallback 113
+#define g_tracking 114
+#define g_trackingSeq 115
+#define g_trackingStamp 116
+#define g_runnableTime 124
+#define g_lockedm 132
+#define g_sig 136
+#define g_writebuf 140
+#define g_sigcode0 152
+#define g_sigcode1 156
+#define g_sigpc 160
+#define g_parentGoid 164
+#define g_gopc 172
+#define g_ancestors 176
+#define g_startpc 180
+#define g_racectx 184
+#define g_waiting 188
+#define g_cgoCtxt 192
+#define g_labels 204
+#define g_timer 208
+#define g_selectDone 212
+#define g_coroarg 216
+#define g_goroutineProfiled 220
+#define g_trace 224
+#define g_gcAssistBytes 252
+#define m__size 1296
+#define m_g0 0
+#define m_morebuf 4
+#define m_divmod 32
+#define m_procid 40
+#define m_gsignal 48
+#define m_goSigStack 52
+#define m_sigmask 72
+#define m_tls 80
+#define m_mstartfn 104
+#define m_curg 108
+#define m_caughtsig 112
+#define m_p 116
+#define m_nextp 120
+#define m_oldp 124
+#define m_id 128
+#define m_mallocing 136
+#define m_throwing 140
+#define m_preemptoff 144
+#define m_locks 152
+#define m_dying 156
+#define m_profilehz 160
+#define m_spinning 164
+#define m_blocked 165
+#define m_newSigstack 166
+#define m_printlock 167
+#define m_incgo 168
+#define m_isextra 169
+#define m_isExtraInC 170
+#define m_isExtraInSig 171
+#define m_freeWait 172
+#define m_needextram 176
+#define m_traceback 177
+#define m_ncgocall 180
+#define m_ncgo 188
+#define m_cgoCallersUse 192
+#define m_cgoCallers 196
+#define m_park 200
+#define m_alllink 204
+#define m_schedlink 208
+#define m_lockedg 212
+#define m_createstack 216
+#define m_lockedExt 344
+#define m_lockedInt 348
+#define m_nextwaitm 352
+#define m_mLockProfile 360
+#define m_waitunlockf 520
+#define m_waitlock 524
+#define m_waitTraceBlockReason 528
+#define m_waitTraceSkip 532
+#define m_syscalltick 536
+#define m_freelink 540
+#define m_trace 544
+#define m_libcall 560
+#define m_libcallpc 584
+#define m_libcallsp 588
+#define m_libcallg 592
+#define m_syscall 596
+#define m_vdsoSP 620
+#define m_vdsoPC 624
+#define m_preemptGen 628
+#define m_signalPending 632
+#define m_pcvalueCache 636
+#define m_dlogPerM 896
+#define m_mOS 896
+#define m_chacha8 904
+#define m_cheaprand 1204
+#define m_locksHeldLen 1212
+#define m_locksHeld 1216
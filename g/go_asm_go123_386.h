@@ -0,0 +1,131 @@
+// Layout of the go1.23 runtime g and m, in the format of go tool compile -asmhdr.
+
+#define g__size 272
+#define g_stack 0
+#define g_stackguard0 8
+#define g_stackguard1 12
+#define g__panic 16
+#define g__defer 20
+#define g_m 24
+#define g_sched 28
+#define g_syscallsp 56
+#define g_syscallpc 60
+#define g_syscallbp 64
+#define g_stktopsp 68
+#define g_param 72
+#define g_atomicstatus 76
+#define g_stackLock 80
+#define g_goid 84
+#define g_schedlink 92
+#define g_waitsince 96
+#define g_waitreason 104
+#define g_preempt 105
+#define g_preemptStop 106
+#define g_preemptShrink 107
+#define g_asyncSafePoint 108
+#define g_paniconfault 109
+#define g_gcscandone 110
+#define g_throwsplit 111
+#define g_activeStackChans 112
+#define g_parkingOnChan 113
+#define g_inMarkAssist 114
+#define g_coroexit 115
+#define g_raceignore 116
+#define g_nocgocallback 117
+#define g_tracking 118
+#define g_trackingSeq 119
+#define g_trackingStamp 120
+#define g_runnableTime 128
+#define g_lockedm 136
+#define g_sig 140
+#define g_writebuf 144
+#define g_sigcode0 156
+#define g_sigcode1 160
+#define g_sigpc 164
+#define g_parentGoid 168
+#define g_gopc 176
+#define g_ancestors 180
+#define g_startpc 184
+#define g_racectx 188
+#define g_waiting 192
+#define g_cgoCtxt 196
+#define g_labels 208
+#define g_timer 212
+#define g_sleepWhen 216
+#define g_selectDone 224
+#define g_goroutineProfiled 228
+#define g_coroarg 232
+#define g_trace 236
+#define g_gcAssistBytes 264
+#define m__size 1184
+#define m_g0 0
+#define m_morebuf 4
+#define m_divmod 32
+#define m_procid 40
+#define m_gsignal 48
+#define m_goSigStack 52
+#define m_sigmask 72
+#define m_tls 80
+#define m_mstartfn 104
+#define m_curg 108
+#define m_caughtsig 112
+#define m_p 116
+#define m_nextp 120
+#define m_oldp 124
+#define m_id 128
+#define m_mallocing 136
+#define m_throwing 140
+#define m_preemptoff 144
+#define m_locks 152
+#define m_dying 156
+#define m_profilehz 160
+#define m_spinning 164
+#define m_blocked 165
+#define m_newSigstack 166
+#define m_printlock 167
+#define m_incgo 168
+#define m_isextra 169
+#define m_isExtraInC 170
+#define m_isExtraInSig 171
+#define m_freeWait 172
+#define m_needextram 176
+#define m_g0StackAccurate 177
+#define m_traceback 178
+#define m_allpSnapshot 180
+#define m_ncgocall 192
+#define m_ncgo 200
+#define m_cgoCallersUse 204
+#define m_cgoCallers 208
+#define m_park 212
+#define m_alllink 216
+#define m_schedlink 220
+#define m_lockedg 224
+#define m_createstack 228
+#define m_lockedExt 356
+#define m_lockedInt 360
+#define m_nextwaitm 364
+#define m_mLockProfile 368
+#define m_profStack 416
+#define m_waitunlockf 428
+#define m_waitlock 432
+#define m_waitTraceSkip 436
+#define m_waitTraceBlockReason 440
+#define m_syscalltick 444
+#define m_freelink 448
+#define m_trace 452
+#define m_libcall 468
+#define m_libcallpc 492
+#define m_libcallsp 496
+#define m_libcallg 500
+#define m_winsyscall 504
+#define m_vdsoSP 504
+#define m_vdsoPC 508
+#define m_preemptGen 512
+#define m_signalPending 516
+#define m_pcvalueCache 520
+#define m_dlogPerM 780
+#define m_mOS 780
+#define m_chacha8 788
+#define m_cheaprand 1088
+#define m_locksHeldLen 1096
+#define m_locksHeld 1100
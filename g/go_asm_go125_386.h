@@ -0,0 +1,136 @@
+// Layout of the go1.25 runtime g and m, in the format of go tool compile -asmhdr.
+
+#define g__size 280
+#define g_stack 0
+#define g_stackguard0 8
+#define g_stackguard1 12
+#define g__panic 16
+#define g__defer 20
+#define g_m 24
+#define g_sched 28
+#define g_syscallsp 52
+#define g_syscallpc 56
+#define g_syscallbp 60
+#define g_stktopsp 64
+#define g_param 68
+#define g_atomicstatus 72
+#define g_stackLock 76
+#define g_goid 80
+#define g_schedlink 88
+#define g_waitsince 92
+#define g_waitreason 100
+#define g_preempt 101
+#define g_preemptStop 102
+#define g_preemptShrink 103
+#define g_asyncSafePoint 104
+#define g_paniconfault 105
+#define g_gcscandone 106
+#define g_throwsplit 107
+#define g_activeStackChans 108
+#define g_parkingOnChan 109
+#define g_inMarkAssist 110
+#define g_coroexit 111
+#define g_raceignore 112
+#define g_nocgocallback 113
+#define g_tracking 114
+#define g_trackingSeq 115
+#define g_trackingStamp 116
+#define g_runnableTime 124
+#define g_lockedm 132
+#define g_fipsIndicator 136
+#define g_syncSafePoint 137
+#define g_runningCleanups 138
+#define g_sig 140
+#define g_writebuf 144
+#define g_sigcode0 156
+#define g_sigcode1 160
+#define g_sigpc 164
+#define g_parentGoid 168
+#define g_gopc 176
+#define g_ancestors 180
+#define g_startpc 184
+#define g_racectx 188
+#define g_waiting 192
+#define g_cgoCtxt 196
+#define g_labels 208
+#define g_timer 212
+#define g_sleepWhen 216
+#define g_selectDone 224
+#define g_goroutineProfiled 228
+#define g_coroarg 232
+#define g_bubble 236
+#define g_trace 240
+#define g_gcAssistBytes 268
+#define g_valgrindStackID 276
+#define m__size 1200
+#define m_g0 0
+#define m_morebuf 4
+#define m_divmod 28
+#define m_procid 32
+#define m_gsignal 40
+#define m_goSigStack 44
+#define m_sigmask 64
+#define m_tls 72
+#define m_mstartfn 96
+#define m_curg 100
+#define m_caughtsig 104
+#define m_p 108
+#define m_nextp 112
+#define m_oldp 116
+#define m_id 120
+#define m_mallocing 128
+#define m_throwing 132
+#define m_preemptoff 136
+#define m_locks 144
+#define m_dying 148
+#define m_profilehz 152
+#define m_spinning 156
+#define m_blocked 157
+#define m_newSigstack 158
+#define m_printlock 159
+#define m_incgo 160
+#define m_isextra 161
+#define m_isExtraInC 162
+#define m_isExtraInSig 163
+#define m_freeWait 164
+#define m_needextram 168
+#define m_g0StackAccurate 169
+#define m_traceback 170
+#define m_allpSnapshot 172
+#define m_ncgocall 184
+#define m_ncgo 192
+#define m_cgoCallersUse 196
+#define m_cgoCallers 200
+#define m_park 204
+#define m_alllink 208
+#define m_schedlink 212
+#define m_lockedg 216
+#define m_createstack 220
+#define m_lockedExt 348
+#define m_lockedInt 352
+#define m_mWaitList 356
+#define m_mLockProfile 368
+#define m_profStack 408
+#define m_waitunlockf 420
+#define m_waitlock 424
+#define m_waitTraceSkip 428
+#define m_waitTraceBlockReason 432
+#define m_syscalltick 436
+#define m_freelink 440
+#define m_trace 444
+#define m_libcall 476
+#define m_libcallpc 500
+#define m_libcallsp 504
+#define m_libcallg 508
+#define m_winsyscall 512
+#define m_vdsoSP 512
+#define m_vdsoPC 516
+#define m_preemptGen 520
+#define m_signalPending 524
+#define m_pcvalueCache 528
+#define m_dlogPerM 788
+#define m_mOS 788
+#define m_chacha8 804
+#define m_cheaprand 1104
+#define m_locksHeldLen 1112
+#define m_locksHeld 1116
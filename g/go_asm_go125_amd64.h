@@ -0,0 +1,136 @@
+// Layout of the go1.25 runtime g and m, in the format of go tool compile -asmhdr.
+
+#define g__size 440
+#define g_stack 0
+#define g_stackguard0 16
+#define g_stackguard1 24
+#define g__panic 32
+#define g__defer 40
+#define g_m 48
+#define g_sched 56
+#define g_syscallsp 104
+#define g_syscallpc 112
+#define g_syscallbp 120
+#define g_stktopsp 128
+#define g_param 136
+#define g_atomicstatus 144
+#define g_stackLock 148
+#define g_goid 152
+#define g_schedlink 160
+#define g_waitsince 168
+#define g_waitreason 176
+#define g_preempt 177
+#define g_preemptStop 178
+#define g_preemptShrink 179
+#define g_asyncSafePoint 180
+#define g_paniconfault 181
+#define g_gcscandone 182
+#define g_throwsplit 183
+#define g_activeStackChans 184
+#define g_parkingOnChan 185
+#define g_inMarkAssist 186
+#define g_coroexit 187
+#define g_raceignore 188
+#define g_nocgocallback 189
+#define g_tracking 190
+#define g_trackingSeq 191
+#define g_trackingStamp 192
+#define g_runnableTime 200
+#define g_lockedm 208
+#define g_fipsIndicator 216
+#define g_syncSafePoint 217
+#define g_runningCleanups 218
+#define g_sig 220
+#define g_writebuf 224
+#define g_sigcode0 248
+#define g_sigcode1 256
+#define g_sigpc 264
+#define g_parentGoid 272
+#define g_gopc 280
+#define g_ancestors 288
+#define g_startpc 296
+#define g_racectx 304
+#define g_waiting 312
+#define g_cgoCtxt 320
+#define g_labels 344
+#define g_timer 352
+#define g_sleepWhen 360
+#define g_selectDone 368
+#define g_goroutineProfiled 372
+#define g_coroarg 376
+#define g_bubble 384
+#define g_trace 392
+#define g_gcAssistBytes 424
+#define g_valgrindStackID 432
+#define m__size 1816
+#define m_g0 0
+#define m_morebuf 8
+#define m_divmod 56
+#define m_procid 64
+#define m_gsignal 72
+#define m_goSigStack 80
+#define m_sigmask 120
+#define m_tls 128
+#define m_mstartfn 176
+#define m_curg 184
+#define m_caughtsig 192
+#define m_p 200
+#define m_nextp 208
+#define m_oldp 216
+#define m_id 224
+#define m_mallocing 232
+#define m_throwing 236
+#define m_preemptoff 240
+#define m_locks 256
+#define m_dying 260
+#define m_profilehz 264
+#define m_spinning 268
+#define m_blocked 269
+#define m_newSigstack 270
+#define m_printlock 271
+#define m_incgo 272
+#define m_isextra 273
+#define m_isExtraInC 274
+#define m_isExtraInSig 275
+#define m_freeWait 276
+#define m_needextram 280
+#define m_g0StackAccurate 281
+#define m_traceback 282
+#define m_allpSnapshot 288
+#define m_ncgocall 312
+#define m_ncgo 320
+#define m_cgoCallersUse 324
+#define m_cgoCallers 328
+#define m_park 336
+#define m_alllink 344
+#define m_schedlink 352
+#define m_lockedg 360
+#define m_createstack 368
+#define m_lockedExt 624
+#define m_lockedInt 628
+#define m_mWaitList 632
+#define m_mLockProfile 648
+#define m_profStack 704
+#define m_waitunlockf 728
+#define m_waitlock 736
+#define m_waitTraceSkip 744
+#define m_waitTraceBlockReason 752
+#define m_syscalltick 756
+#define m_freelink 760
+#define m_trace 768
+#define m_libcall 824
+#define m_libcallpc 872
+#define m_libcallsp 880
+#define m_libcallg 888
+#define m_winsyscall 896
+#define m_vdsoSP 896
+#define m_vdsoPC 904
+#define m_preemptGen 912
+#define m_signalPending 916
+#define m_pcvalueCache 920
+#define m_dlogPerM 1312
+#define m_mOS 1312
+#define m_chacha8 1336
+#define m_cheaprand 1640
+#define m_locksHeldLen 1648
+#define m_locksHeld 1656
@@ -0,0 +1,143 @@
+// Layout of the go1.26 runtime g and m, in the format of go tool compile -asmhdr.
+
+#define g__size 288
+#define g_stack 0
+#define g_stackguard0 8
+#define g_stackguard1 12
+#define g__panic 16
+#define g__defer 20
+#define g_m 24
+#define g_sched 28
+#define g_syscallsp 52
+#define g_syscallpc 56
+#define g_syscallbp 60
+#define g_stktopsp 64
+#define g_param 68
+#define g_atomicstatus 72
+#define g_stackLock 76
+#define g_goid 80
+#define g_schedlink 88
+#define g_waitsince 92
+#define g_waitreason 100
+#define g_preempt 101
+#define g_preemptStop 102
+#define g_preemptShrink 103
+#define g_asyncSafePoint 104
+#define g_paniconfault 105
+#define g_gcscandone 106
+#define g_throwsplit 107
+#define g_activeStackChans 108
+#define g_parkingOnChan 109
+#define g_inMarkAssist 110
+#define g_coroexit 111
+#define g_raceignore 112
+#define g_nocgocallback 113
+#define g_tracking 114
+#define g_trackingSeq 115
+#define g_trackingStamp 116
+#define g_runnableTime 124
+#define g_lockedm 132
+#define g_fipsIndicator 136
+#define g_fipsOnlyBypass 137
+#define g_ditWanted 138
+#define g_syncSafePoint 139
+#define g_runningCleanups 140
+#define g_sig 144
+#define g_secret 148
+#define g_writebuf 152
+#define g_sigcode0 164
+#define g_sigcode1 168
+#define g_sigpc 172
+#define g_parentGoid 176
+#define g_gopc 184
+#define g_ancestors 188
+#define g_startpc 192
+#define g_racectx 196
+#define g_waiting 200
+#define g_cgoCtxt 204
+#define g_labels 216
+#define g_timer 220
+#define g_sleepWhen 224
+#define g_selectDone 232
+#define g_goroutineProfiled 236
+#define g_coroarg 240
+#define g_bubble 244
+#define g_xRegs 248
+#define g_trace 248
+#define g_gcAssistBytes 276
+#define g_valgrindStackID 284
+#define m__size 1200
+#define m_g0 0
+#define m_morebuf 4
+#define m_divmod 28
+#define m_procid 32
+#define m_gsignal 40
+#define m_goSigStack 44
+#define m_sigmask 64
+#define m_tls 72
+#define m_mstartfn 96
+#define m_curg 100
+#define m_caughtsig 104
+#define m_signalSecret 108
+#define m_p 112
+#define m_nextp 116
+#define m_oldp 120
+#define m_id 124
+#define m_mallocing 132
+#define m_throwing 136
+#define m_preemptoff 140
+#define m_locks 148
+#define m_dying 152
+#define m_profilehz 156
+#define m_spinning 160
+#define m_blocked 161
+#define m_newSigstack 162
+#define m_printlock 163
+#define m_incgo 164
+#define m_isextra 165
+#define m_isExtraInC 166
+#define m_isExtraInSig 167
+#define m_freeWait 168
+#define m_needextram 172
+#define m_g0StackAccurate 173
+#define m_traceback 174
+#define m_allpSnapshot 176
+#define m_ncgocall 188
+#define m_ncgo 196
+#define m_cgoCallersUse 200
+#define m_cgoCallers 204
+#define m_park 208
+#define m_alllink 212
+#define m_schedlink 216
+#define m_idleNode 220
+#define m_lockedg 228
+#define m_createstack 232
+#define m_lockedExt 360
+#define m_lockedInt 364
+#define m_mWaitList 368
+#define m_ditEnabled 380
+#define m_mLockProfile 384
+#define m_profStack 424
+#define m_waitunlockf 436
+#define m_waitlock 440
+#define m_waitTraceSkip 444
+#define m_waitTraceBlockReason 448
+#define m_syscalltick 452
+#define m_freelink 456
+#define m_trace 460
+#define m_libcallpc 496
+#define m_libcallsp 500
+#define m_libcallg 504
+#define m_winsyscall 508
+#define m_vdsoSP 508
+#define m_vdsoPC 512
+#define m_preemptGen 516
+#define m_signalPending 520
+#define m_pcvalueCache 524
+#define m_dlogPerM 784
+#define m_mOS 784
+#define m_chacha8 800
+#define m_cheaprand 1100
+#define m_locksHeldLen 1108
+#define m_locksHeld 1112
+#define m_self 1192
@@ -0,0 +1,144 @@
+// Layout of the go1.27 runtime g and m, in the format of go tool compile -asmhdr.
+
+#define g__size 456
+#define g_stack 0
+#define g_stackguard0 16
+#define g_stackguard1 24
+#define g__panic 32
+#define g__defer 40
+#define g_m 48
+#define g_sched 56
+#define g_syscallsp 104
+#define g_syscallpc 112
+#define g_syscallbp 120
+#define g_stktopsp 128
+#define g_param 136
+#define g_atomicstatus 144
+#define g_stackLock 148
+#define g_goid 152
+#define g_schedlink 160
+#define g_waitsince 168
+#define g_waitreason 176
+#define g_preempt 177
+#define g_preemptStop 178
+#define g_preemptShrink 179
+#define g_asyncSafePoint 180
+#define g_paniconfault 181
+#define g_gcscandone 182
+#define g_throwsplit 183
+#define g_activeStackChans 184
+#define g_parkingOnChan 185
+#define g_inMarkAssist 186
+#define g_coroexit 187
+#define g_raceignore 188
+#define g_nocgocallback 189
+#define g_tracking 190
+#define g_trackingSeq 191
+#define g_trackingStamp 192
+#define g_runnableTime 200
+#define g_lockedm 208
+#define g_fipsIndicator 216
+#define g_fipsOnlyBypass 217
+#define g_ditWanted 218
+#define g_syncSafePoint 219
+#define g_runningCleanups 220
+#define g_sig 224
+#define g_secret 228
+#define g_writebuf 232
+#define g_sigcode0 256
+#define g_sigcode1 264
+#define g_sigpc 272
+#define g_parentGoid 280
+#define g_gopc 288
+#define g_ancestors 296
+#define g_startpc 304
+#define g_racectx 312
+#define g_waiting 320
+#define g_cgoCtxt 328
+#define g_labels 352
+#define g_timer 360
+#define g_sleepWhen 368
+#define g_selectDone 376
+#define g_goroutineProfiled 380
+#define g_coroarg 384
+#define g_bubble 392
+#define g_xRegs 400
+#define g_trace 408
+#define g_gcAssistBytes 440
+#define g_valgrindStackID 448
+#define m__size 1832
+#define m_g0 0
+#define m_morebuf 8
+#define m_divmod 56
+#define m_procid 64
+#define m_gsignal 72
+#define m_goSigStack 80
+#define m_sigmask 120
+#define m_tls 128
+#define m_mstartfn 176
+#define m_curg 184
+#define m_caughtsig 192
+#define m_signalSecret 200
+#define m_p 208
+#define m_nextp 216
+#define m_oldp 224
+#define m_id 232
+#define m_mallocing 240
+#define m_throwing 244
+#define m_preemptoff 248
+#define m_locks 264
+#define m_dying 268
+#define m_profilehz 272
+#define m_spinning 276
+#define m_blocked 277
+#define m_newSigstack 278
+#define m_printlock 279
+#define m_incgo 280
+#define m_isextra 281
+#define m_isExtraInC 282
+#define m_isExtraInSig 283
+#define m_freeWait 284
+#define m_needextram 288
+#define m_g0StackAccurate 289
+#define m_traceback 290
+#define m_allpSnapshot 296
+#define m_ncgocall 320
+#define m_ncgo 328
+#define m_cgoCallersUse 332
+#define m_cgoCallers 336
+#define m_park 344
+#define m_alllink 352
+#define m_schedlink 360
+#define m_idleNode 368
+#define m_lockedg 384
+#define m_createstack 392
+#define m_lockedExt 648
+#define m_lockedInt 652
+#define m_mWaitList 656
+#define m_ditEnabled 672
+#define m_mLockProfile 680
+#define m_profStack 736
+#define m_waitunlockf 760
+#define m_waitlock 768
+#define m_waitTraceSkip 776
+#define m_waitTraceBlockReason 784
+#define m_syscalltick 788
+#define m_freelink 792
+#define m_trace 800
+#define m_libcallpc 872
+#define m_libcallsp 880
+#define m_libcallg 888
+#define m_winsyscall 896
+#define m_vdsoSP 896
+#define m_vdsoPC 904
+#define m_preemptGen 912
+#define m_signalPending 916
+#define m_pcvalueCache 920
+#define m_dlogPerM 1312
+#define m_mOS 1312
+#define m_chacha8 1336
+#define m_cheaprand 1640
+#define m_cheaprand64 1648
+#define m_locksHeldLen 1656
+#define m_locksHeld 1664
+#define m_self 1824
@@ -0,0 +1,29 @@
+package g
+
+import (
+	_ "unsafe"
+)
+
+// NoteClear resets n, there must be no sleeper and no waker.
+//
+//go:linkname NoteClear runtime.noteclear
+func NoteClear(n *Note)
+
+// NoteWakeup wakes the sleeper of n. A second wakeup throws.
+//
+//go:linkname NoteWakeup runtime.notewakeup
+func NoteWakeup(n *Note)
+
+// NoteSleep blocks the M until n is woken. It must run on the system
+// stack (g0), it throws on a user goroutine.
+//
+//go:linkname NoteSleep runtime.notesleep
+func NoteSleep(n *Note)
+
+// NoteTSleepG blocks the current goroutine and its M until n is woken or
+// ns nanoseconds elapse, forever if ns < 0, and reports whether n was
+// woken. The P is handed off as in a blocking syscall. It must run on a
+// user goroutine.
+//
+//go:linkname NoteTSleepG runtime.notetsleepg
+func NoteTSleepG(n *Note, ns int64) bool
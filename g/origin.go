@@ -0,0 +1,101 @@
+package g
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// Origin tells where a goroutine comes from, the way the "created by"
+// line of a traceback does.
+type Origin struct {
+	GoID      uint64
+	CreatedBy string // function that executed the go statement
+	File      string // position of the go statement
+	Line      int
+	Entry     string // goroutine function
+
+	// Ancestors are the goroutines that created this one, innermost
+	// first. They are only recorded with GODEBUG=tracebackancestors=N.
+	Ancestors []Ancestor
+}
+
+// Ancestor is a goroutine of the creation chain, which may have exited
+// since.
+type Ancestor struct {
+	GoID      uint64
+	CreatedBy string // function that executed the go statement
+	File      string // position of the go statement
+	Line      int
+
+	// Stack is the stack of the goroutine at the time it created the
+	// next one of the chain.
+	Stack []runtime.Frame
+}
+
+// GoroutineOrigin resolves the creation site, entry function and
+// ancestors of gp. The main goroutine and the runtime ones started
+// without a go statement have no CreatedBy.
+func GoroutineOrigin(gp *G) Origin {
+	o := Origin{GoID: uint64(gp.GoID)}
+	o.CreatedBy, o.File, o.Line = goSite(gp.GoPC)
+	if f := runtime.FuncForPC(gp.StartPC); f != nil {
+		o.Entry = f.Name()
+	}
+	if gp.Ancestors != nil {
+		for _, a := range *gp.Ancestors {
+			anc := Ancestor{GoID: uint64(a.GoID)}
+			anc.CreatedBy, anc.File, anc.Line = goSite(a.GoPC)
+			if len(a.PCS) > 0 {
+				frames := runtime.CallersFrames(a.PCS)
+				for {
+					fr, more := frames.Next()
+					anc.Stack = append(anc.Stack, fr)
+					if !more {
+						break
+					}
+				}
+			}
+			o.Ancestors = append(o.Ancestors, anc)
+		}
+	}
+	return o
+}
+
+// goSite resolves the pc of a go statement.
+func goSite(pc uintptr) (fn, file string, line int) {
+	f := runtime.FuncForPC(pc)
+	if f == nil {
+		return "", "", 0
+	}
+	// gopc is the return address of the call into newproc, step back
+	// into the call instruction as the traceback does.
+	tracepc := pc
+	if pc > f.Entry() {
+		tracepc--
+	}
+	file, line = f.FileLine(tracepc)
+	return f.Name(), file, line
+}
+
+// String formats the origin as the traceback does:
+//
+//	created by main.main
+//		/src/main.go:12
+func (o Origin) String() string {
+	if o.CreatedBy == "" {
+		return ""
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "created by %s\n\t%s:%d", o.CreatedBy, o.File, o.Line)
+	for _, a := range o.Ancestors {
+		fmt.Fprintf(&b, "\n[originating from goroutine %d]:", a.GoID)
+		for _, fr := range a.Stack {
+			fmt.Fprintf(&b, "\n%s(...)\n\t%s:%d", fr.Function, fr.File, fr.Line)
+		}
+		if a.CreatedBy != "" {
+			fmt.Fprintf(&b, "\ncreated by %s\n\t%s:%d", a.CreatedBy, a.File, a.Line)
+		}
+	}
+	return b.String()
+}
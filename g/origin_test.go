@@ -0,0 +1,66 @@
+package g
+
+import (
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// originWorker takes no arguments, so the go statement does not wrap it.
+var (
+	originGPs  = make(chan *G)
+	originDone = make(chan struct{})
+)
+
+func originWorker() {
+	originGPs <- CurG()
+	<-originDone
+}
+
+func TestGoroutineOrigin(t *testing.T) {
+	_, _, line, _ := runtime.Caller(0)
+	go originWorker()
+	o := GoroutineOrigin(<-originGPs)
+	originDone <- struct{}{}
+
+	if o.CreatedBy != "github.com/sitano/gsysint/g.TestGoroutineOrigin" {
+		t.Errorf("CreatedBy = %q", o.CreatedBy)
+	}
+	if !strings.HasSuffix(o.File, "origin_test.go") || o.Line != line+1 {
+		t.Errorf("created at %s:%d, want origin_test.go:%d", o.File, o.Line, line+1)
+	}
+	if o.Entry != "github.com/sitano/gsysint/g.originWorker" {
+		t.Errorf("Entry = %q", o.Entry)
+	}
+	if len(o.Ancestors) != 0 && os.Getenv("GODEBUG") == "" {
+		t.Errorf("ancestors without tracebackancestors: %+v", o.Ancestors)
+	}
+	if s := o.String(); !strings.HasPrefix(s, "created by github.com/sitano/gsysint/g.TestGoroutineOrigin\n\t") {
+		t.Errorf("String() = %q", s)
+	}
+}
+
+func TestGoroutineOriginAncestors(t *testing.T) {
+	if os.Getenv("GSYSINT_ORIGIN_CHILD") == "" {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestGoroutineOriginAncestors$")
+		cmd.Env = append(os.Environ(), "GSYSINT_ORIGIN_CHILD=1", "GODEBUG=tracebackancestors=5")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("%v\n%s", err, out)
+		}
+		return
+	}
+
+	parent := uint64(CurG().GoID)
+	go originWorker()
+	o := GoroutineOrigin(<-originGPs)
+	originDone <- struct{}{}
+
+	if len(o.Ancestors) == 0 {
+		t.Fatal("no ancestors with tracebackancestors=5")
+	}
+	if a := o.Ancestors[0]; a.GoID != parent || len(a.Stack) == 0 {
+		t.Fatalf("first ancestor %d with %d frames, want %d", a.GoID, len(a.Stack), parent)
+	}
+}
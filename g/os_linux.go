@@ -4,8 +4,6 @@
 
 package g
 
-type SigSet struct{}
+type SigSet [2]uint32
 
-type MOS struct {
-	WaitSema uintptr // semaphore for parking on locks
-}
\ No newline at end of file
+type WinLibCall struct{}
@@ -0,0 +1,8 @@
+// Copyright 2025 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package g
+
+// XRegPerG is empty on platforms that don't use extended register state.
+type XRegPerG struct{}
@@ -0,0 +1,15 @@
+// Copyright 2025 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package g
+
+import (
+	"unsafe"
+)
+
+// XRegPerG stores the extended register state of an asynchronously
+// preempted G.
+type XRegPerG struct {
+	State unsafe.Pointer
+}
@@ -0,0 +1,804 @@
+// Copyright 2009 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by go run ./gen; DO NOT EDIT.
+
+//go:build go1.25 && !go1.26
+// +build go1.25,!go1.26
+
+package g
+
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+// Layouts of the go1.25 runtime structures.
+
+type GoBuf struct {
+	// The offsets of sp, pc, and g are known to (hard-coded in) libmach.
+	//
+	// ctxt is unusual with respect to GC: it may be a
+	// heap-allocated funcval, so GC needs to track it, but it
+	// needs to be set and cleared from assembly, where it's
+	// difficult to have write barriers. However, ctxt is really a
+	// saved, live register, and we only ever exchange it between
+	// the real register and the gobuf. Hence, we treat it as a
+	// root during stack scanning, which means assembly that saves
+	// and restores it doesn't need write barriers. It's still
+	// typed as a pointer so that any other writes from Go get
+	// write barriers.
+	sp   uintptr
+	pc   uintptr
+	g    Guintptr
+	ctxt unsafe.Pointer
+	lr   uintptr
+	bp   uintptr // for framepointer-enabled architectures
+}
+
+type Sudog struct {
+	g *G
+
+	next *Sudog
+	prev *Sudog
+	elem unsafe.Pointer // data element (may point to stack)
+
+	acquiretime int64
+	releasetime int64
+	ticket      uint32
+
+	// isSelect indicates g is participating in a select, so
+	// g.selectDone must be CAS'd to win the wake-up race.
+	isSelect bool
+
+	// success indicates whether communication over channel c
+	// succeeded. It is true if the goroutine was awoken because a
+	// value was delivered over channel c, and false if awoken
+	// because c was closed.
+	success bool
+
+	// waiters is a count of semaRoot waiting list other than head of list,
+	// clamped to a uint16 to fit in unused space.
+	// Only meaningful at the head of the list.
+	// (If we wanted to be overly clever, we could store a high 16 bits
+	// in the second entry in the list.)
+	waiters uint16
+
+	parent   *Sudog // semaRoot binary tree
+	waitlink *Sudog // g.waiting list or semaRoot
+	waittail *Sudog // semaRoot
+	c        *HChan // channel
+}
+
+type HChan struct {
+	qcount   uint           // total data in the queue
+	dataqsiz uint           // size of the circular queue
+	buf      unsafe.Pointer // points to an array of dataqsiz elements
+	elemsize uint16
+	closed   uint32
+	timer    *Timer // timer feeding this chan
+	elemtype *Type  // element type
+	sendx    uint   // send index
+	recvx    uint   // receive index
+	recvq    WaitQ  // list of recv waiters
+	sendq    WaitQ  // list of send waiters
+	bubble   unsafe.Pointer
+
+	// lock protects all fields in hchan, as well as several
+	// fields in sudogs blocked on this channel.
+	//
+	// Do not change another G's status while holding this lock
+	// (in particular, do not ready a G), as this can deadlock
+	// with stack shrinking.
+	lock Mutex
+}
+
+type Timer struct {
+	// mu protects reads and writes to all fields, with exceptions noted below.
+	Mu Mutex
+
+	AState uint8 // atomic copy of state bits at last unlock
+	State  uint8 // state bits
+	IsChan bool  // timer has a channel; immutable; can be read without lock
+	IsFake bool  // timer is using fake time; immutable; can be read without lock
+
+	Blocked uint32 // number of goroutines blocked on timer's channel
+	Rand    uint32 // randomizes order of timers at same instant; only set when isFake
+
+	// Timer wakes up at when, and then at when+period, ... (period > 0 only)
+	// each time calling f(arg, seq, delay) in the timer goroutine, so f must be
+	// a well-behaved function and not block.
+	//
+	// The arg and seq are client-specified opaque arguments passed back to f.
+	// When used from netpoll, arg and seq have meanings defined by netpoll
+	// and are completely opaque to this code; in that context, seq is a sequence
+	// number to recognize and squelch stale function invocations.
+	// When used from package time, arg is a channel (for After, NewTicker)
+	// or the function to call (for AfterFunc) and seq is unused (0).
+	//
+	// Package time does not know about seq, but if this is a channel timer (t.isChan == true),
+	// this file uses t.seq as a sequence number to recognize and squelch
+	// sends that correspond to an earlier (stale) timer configuration,
+	// similar to its use in netpoll. In this usage (that is, when t.isChan == true),
+	// writes to seq are protected by both t.mu and t.sendLock,
+	// so reads are allowed when holding either of the two mutexes.
+	//
+	// The delay argument is nanotime() - t.when, meaning the delay in ns between
+	// when the timer should have gone off and now. Normally that amount is
+	// small enough not to matter, but for channel timers that are fed lazily,
+	// the delay can be arbitrarily long; package time subtracts it out to make
+	// it look like the send happened earlier than it actually did.
+	// (No one looked at the channel since then, or the send would have
+	// not happened so late, so no one can tell the difference.)
+	When   int64
+	Period int64
+	F      func(interface{}, uintptr, int64)
+	Arg    interface{}
+	Seq    uintptr
+
+	// If non-nil, the timers containing t.
+	TS unsafe.Pointer
+
+	// sendLock protects sends on the timer's channel.
+	// Not used for async (pre-Go 1.23) behavior when debug.asynctimerchan.Load() != 0.
+	SendLock Mutex
+
+	// isSending is used to handle races between running a
+	// channel timer and stopping or resetting the timer.
+	// It is used only for channel timers (t.isChan == true).
+	// It is not used for tickers.
+	// The value is incremented when about to send a value on the channel,
+	// and decremented after sending the value.
+	// The stop/reset code uses this to detect whether it
+	// stopped the channel send.
+	//
+	// isSending is incremented only when t.mu is held.
+	// isSending is decremented only when t.sendLock is held.
+	// isSending is read only when both t.mu and t.sendLock are held.
+	IsSending int32
+}
+
+type Defer struct {
+	heap      bool
+	rangefunc bool    // true for rangefunc list
+	sp        uintptr // sp at time of defer
+	pc        uintptr // pc at time of defer
+	fn        func()  // can be nil for open-coded defers
+	link      *Defer  // next defer on G; can point to either heap or stack!
+
+	// If rangefunc is true, *head is the head of the atomic linked list
+	// during a range-over-func execution.
+	head *unsafe.Pointer
+}
+
+type Panic struct {
+	argp unsafe.Pointer // pointer to arguments of deferred call run during panic; cannot move - known to liblink
+	arg  interface{}    // argument to panic
+	link *Panic         // link to earlier panic
+
+	// startPC and startSP track where _panic.start was called.
+	startPC uintptr
+	startSP unsafe.Pointer
+
+	// The current stack frame that we're running deferred calls for.
+	sp unsafe.Pointer
+	lr uintptr
+	fp unsafe.Pointer
+
+	// retpc stores the PC where the panic should jump back to, if the
+	// function last returned by _panic.next() recovers the panic.
+	retpc uintptr
+
+	// Extra state for handling open-coded defers.
+	deferBitsPtr *uint8
+	slotsPtr     unsafe.Pointer
+
+	recovered   bool // whether this panic has been recovered
+	repanicked  bool // whether this panic repanicked
+	goexit      bool
+	deferreturn bool
+}
+
+type G struct {
+	// Stack parameters.
+	// stack describes the actual stack memory: [stack.lo, stack.hi).
+	// stackguard0 is the stack pointer compared in the Go stack growth prologue.
+	// It is stack.lo+StackGuard normally, but can be StackPreempt to trigger a preemption.
+	// stackguard1 is the stack pointer compared in the //go:systemstack stack growth prologue.
+	// It is stack.lo+StackGuard on g0 and gsignal stacks.
+	// It is ~0 on other goroutine stacks, to trigger a call to morestackc (and crash).
+	Stack       Stack   // offset known to runtime/cgo
+	StackGuard0 uintptr // offset known to liblink
+	StackGuard1 uintptr // offset known to liblink
+
+	Panic     *Panic // innermost panic - offset known to liblink
+	Defer     *Defer // innermost defer
+	M         *M     // current m; offset known to arm liblink
+	Sched     GoBuf
+	SysCallSP uintptr // if status==Gsyscall, syscallsp = sched.sp to use during gc
+	SysCallPC uintptr // if status==Gsyscall, syscallpc = sched.pc to use during gc
+	SysCallBP uintptr // if status==Gsyscall, syscallbp = sched.bp to use in fpTraceback
+	StkTopSP  uintptr // expected sp at top of stack, to check in traceback
+	// param is a generic pointer parameter field used to pass
+	// values in particular contexts where other storage for the
+	// parameter would be difficult to find. It is currently used
+	// in four ways:
+	// 1. When a channel operation wakes up a blocked goroutine, it sets param to
+	//    point to the sudog of the completed blocking operation.
+	// 2. By gcAssistAlloc1 to signal back to its caller that the goroutine completed
+	//    the GC cycle. It is unsafe to do so in any other way, because the goroutine's
+	//    stack may have moved in the meantime.
+	// 3. By debugCallWrap to pass parameters to a new goroutine because allocating a
+	//    closure in the runtime is forbidden.
+	// 4. When a panic is recovered and control returns to the respective frame,
+	//    param may point to a savedOpenDeferState.
+	Param        unsafe.Pointer
+	AtomicStatus uint32
+	StackLock    uint32 // sigprof/scang lock; TODO: fold in to atomicstatus
+	GoID         uint64
+	SchedLink    Guintptr
+	WaitSince    int64      // approx time when the g become blocked
+	WaitReason   WaitReason // if status==Gwaiting
+
+	Preempt       bool // preemption signal, duplicates stackguard0 = stackpreempt
+	PreemptStop   bool // transition to _Gpreempted on preemption; otherwise, just deschedule
+	PreemptShrink bool // shrink stack at synchronous safe point
+
+	// asyncSafePoint is set if g is stopped at an asynchronous
+	// safe point. This means there are frames on the stack
+	// without precise pointer information.
+	AsyncSafePoint bool
+
+	PanicOnFault bool // panic (instead of crash) on unexpected fault address
+	GcScanDone   bool // g has scanned stack; protected by _Gscan bit in status
+	ThrowSplit   bool // must not split stack
+	// activeStackChans indicates that there are unlocked channels
+	// pointing into this goroutine's stack. If true, stack
+	// copying needs to acquire channel locks to protect these
+	// areas of the stack.
+	ActiveStackChans bool
+	// parkingOnChan indicates that the goroutine is about to
+	// park on a chansend or chanrecv. Used to signal an unsafe point
+	// for stack shrinking.
+	ParkingOnChan bool
+	// inMarkAssist indicates whether the goroutine is in mark assist.
+	// Used by the execution tracer.
+	InMarkAssist bool
+	CoroExit     bool // argument to coroswitch_m
+
+	RaceIgnore      int8  // ignore race detection events
+	NoCgoCallback   bool  // whether disable callback from C
+	Tracking        bool  // whether we're tracking this G for sched latency statistics
+	TrackingSeq     uint8 // used to decide whether to track this G
+	TrackingStamp   int64 // timestamp of when the G last started being tracked
+	RunnableTime    int64 // the amount of time spent runnable, cleared when running, only used when tracking
+	LockedM         Muintptr
+	FipsIndicator   uint8
+	SyncSafePoint   bool // set if g is stopped at a synchronous safe point.
+	RunningCleanups bool
+	Sig             uint32
+	WriteBuf        []byte
+	SigCode0        uintptr
+	SigCode1        uintptr
+	SigPC           uintptr
+	ParentGoID      uint64          // goid of goroutine that created this goroutine
+	GoPC            uintptr         // pc of go statement that created this goroutine
+	Ancestors       *[]AncestorInfo // ancestor information goroutine(s) that created this goroutine (only used if debug.tracebackancestors)
+	StartPC         uintptr         // pc of goroutine function
+	RaceCtx         uintptr
+	Waiting         *Sudog         // sudog structures this g is waiting on (that have a valid elem ptr); in lock order
+	CgoCtxt         []uintptr      // cgo traceback context
+	Labels          unsafe.Pointer // profiler labels
+	Timer           *Timer         // cached timer for time.Sleep
+	SleepWhen       int64          // when to sleep until
+	SelectDone      uint32         // are we participating in a select and did someone win the race?
+
+	// goroutineProfiled indicates the status of this goroutine's stack for the
+	// current in-progress goroutine profile
+	GoroutineProfiled uint32
+
+	CoroArg unsafe.Pointer // argument during coroutine transfers
+	Bubble  unsafe.Pointer
+
+	// Per-G tracer state.
+	Trace GTraceState
+
+	// gcAssistBytes is this G's GC assist credit in terms of
+	// bytes allocated. If this is positive, then the G has credit
+	// to allocate gcAssistBytes bytes without assisting. If this
+	// is negative, then the G must correct this by performing
+	// scan work. We track this in bytes to make it fast to update
+	// and check for debt in the malloc hot path. The assist ratio
+	// determines how this corresponds to scan work debt.
+	GcAssistBytes int64
+
+	// valgrindStackID is used to track what memory is used for stacks when a program is
+	// built with the "valgrind" build tag, otherwise it is unused.
+	ValgrindStackID uintptr
+}
+
+type M struct {
+	G0      *G     // goroutine with scheduling stack
+	MoreBuf GoBuf  // gobuf arg to morestack
+	DivMod  uint32 // div/mod denominator for arm - known to liblink (cmd/internal/obj/arm/obj5.go)
+
+	// Fields not known to debuggers.
+	ProcID          uint64       // for debuggers, but offset not hard-coded
+	GSignal         *G           // signal-handling g
+	GoSigStack      GSignalStack // Go-allocated signal handling stack
+	SigMask         SigSet       // storage for saved signal mask
+	TLS             [6]uintptr   // thread-local storage (for x86 extern register)
+	MStartFn        func()
+	CurG            *G       // current running goroutine
+	CaughtSig       Guintptr // goroutine running during fatal signal
+	P               Puintptr // attached p for executing go code (nil if not executing go code)
+	NextP           Puintptr
+	OldP            Puintptr // the p that was attached before executing a syscall
+	ID              int64
+	MAllocing       int32
+	Throwing        uint32
+	PreemptOff      string // if != "", keep curg running on this m
+	Locks           int32
+	Dying           int32
+	ProfileHz       int32
+	Spinning        bool // m is out of work and is actively looking for work
+	Blocked         bool // m is blocked on a note
+	NewSigStack     bool // minit on C thread called sigaltstack
+	PrintLock       int8
+	IncGo           bool   // m is executing a cgo call
+	IsExtra         bool   // m is an extra m
+	IsExtraInC      bool   // m is an extra m that does not have any Go frames
+	IsExtraInSig    bool   // m is an extra m in a signal handler
+	FreeWait        uint32 // Whether it is safe to free g0 and delete m (one of freeMRef, freeMStack, freeMWait)
+	NeedextRam      bool
+	G0StackAccurate bool // whether the g0 stack has accurate bounds
+	TraceBack       uint8
+	AllPSnapshot    []*P        // Snapshot of allp for use after dropping P in findRunnable, nil otherwise.
+	NCgoCall        uint64      // number of cgo calls in total
+	NCgo            int32       // number of cgo calls currently in progress
+	CgoCallersUse   uint32      // if non-zero, cgoCallers in use temporarily
+	CgoCallers      *CgoCallers // cgo traceback if crashing in cgo call
+	Park            Note
+	AllLink         *M // on allm
+	SchedLink       Muintptr
+	LockedG         Guintptr
+	CreateStack     [32]uintptr // stack that created this thread, it's used for StackRecord.Stack0, so it must align with it.
+	LockedExt       uint32      // tracking for external LockOSThread
+	LockedInt       uint32      // tracking for internal lockOSThread
+	MWaitList       MWaitList   // list of runtime lock waiters
+
+	MLockProfile LockProfile // fields relating to runtime.lock contention
+	ProfStack    []uintptr   // used for memory/block/mutex stack traces
+
+	// wait* are used to carry arguments from gopark into park_m, because
+	// there's no stack to put them on. That is their sole purpose.
+	WaitUnlockF          func(*G, unsafe.Pointer) bool
+	WaitLock             unsafe.Pointer
+	WaitTraceSkip        int
+	WaitTraceBlockReason uint8
+
+	SysCallTick uint32
+	FreeLink    *M // on sched.freem
+	Trace       MTraceState
+
+	// these are here because they are too large to be on the stack
+	// of low-level NOSPLIT functions.
+	Libcall    LibCall
+	LibCallPC  uintptr // for cpu profiler
+	LibCallSP  uintptr
+	LibCallG   Guintptr
+	WinSysCall WinLibCall // stores syscall parameters on windows
+
+	VDSOSP uintptr // SP for traceback while in VDSO call (0 if not in call)
+	VDSOPC uintptr // PC for traceback while in VDSO call
+
+	// preemptGen counts the number of completed preemption
+	// signals. This is used to detect when a preemption is
+	// requested, but fails.
+	PreemptGen uint32
+
+	// Whether this is a pending preemption signal on this M.
+	SignalPending uint32
+
+	// pcvalue lookup cache
+	PcValueCache PcValueCache
+
+	DlogPerM
+
+	MOS
+
+	Chacha8   [1*unsafe.Sizeof(uintptr(0)) + 296]byte // chacha8rand.State
+	CheapRand uint64
+
+	// Up to 10 locks held by this m, maintained by the lock ranking code.
+	LocksHeldLen int
+	LocksHeld    [10]HeldLockInfo
+}
+
+// P mirrors the leading fields of runtime.p, up to runnext.
+type P struct {
+	ID          int32
+	Status      PStatus // one of pidle/prunning/...
+	Link        Puintptr
+	SchedTick   uint32     // incremented on every scheduler call
+	SysCallTick uint32     // incremented on every system call
+	SysmonTick  SysmonTick // last tick observed by sysmon
+	M           Muintptr   // back-link to associated m (nil if idle)
+	MCache      *MCache
+	PCache      PageCache
+	RaceProcCtx uintptr
+
+	DeferPool    []*Defer // pool of available defer structs (see panic.go)
+	DeferPoolBuf [32]*Defer
+
+	// Cache of goroutine ids, amortizes accesses to runtime·sched.goidgen.
+	GoIDCache    uint64
+	GoIDCacheEnd uint64
+
+	// Queue of runnable goroutines. Accessed without lock.
+	RunqHead uint32
+	RunqTail uint32
+	Runq     [256]Guintptr
+	// runnext, if non-nil, is a runnable G that was ready'd by
+	// the current G and should be run next instead of what's in
+	// runq if there's time remaining in the running G's time
+	// slice. It will inherit the time left in the current time
+	// slice. If a set of goroutines is locked in a
+	// communicate-and-wait pattern, this schedules that set as a
+	// unit and eliminates the (potentially large) scheduling
+	// latency that otherwise arises from adding the ready'd
+	// goroutines to the end of the run queue.
+	//
+	// Note that while other P's may atomically CAS this to zero,
+	// only the owner P can CAS it to a valid G.
+	RunNext Guintptr
+}
+
+// SchedT mirrors the leading fields of runtime.schedt, up to stopwait.
+type SchedT struct {
+	GoIDGen    atomic.Uint64
+	LastPoll   atomic.Int64 // time of last network poll, 0 if currently polling
+	PollUntil  atomic.Int64 // time to which current poll is sleeping
+	PollingNet int32        // 1 if some P doing non-blocking network poll
+
+	Lock Mutex
+
+	MIdle        Muintptr // idle m's waiting for work
+	NMIdle       int32    // number of idle m's waiting for work
+	NMIdleLocked int32    // number of locked m's waiting for work
+	MNext        int64    // number of m's that have been created and next M ID
+	MaxMCount    int32    // maximum number of m's allowed (or die)
+	NMSys        int32    // number of system m's not counted for deadlock
+	NMFreed      int64    // cumulative number of freed m's
+
+	NGSys int32 // number of system goroutines
+
+	PIdle        Puintptr // idle p's
+	NPIdle       int32
+	NMSpinning   int32  // See "Worker thread parking/unparking" comment in proc.go.
+	NeedSpinning uint32 // See "Delicate dance" comment in proc.go. Boolean. Must hold sched.lock to set to 1.
+
+	// Global runnable queue.
+	Runq GQueue
+
+	// disable controls selective disabling of the scheduler.
+	//
+	// Use schedEnableUser to control this.
+	//
+	// disable is protected by sched.lock.
+	Disable struct {
+		User     bool
+		Runnable GQueue
+	}
+
+	// Global cache of dead G's.
+	GFree struct {
+		Lock    Mutex
+		Stack   GList
+		NoStack GList
+	}
+
+	// Central cache of sudog structs.
+	SudogLock  Mutex
+	SudogCache *Sudog
+
+	// Central pool of available defer structs.
+	DeferLock Mutex
+	DeferPool *Defer
+
+	// freem is the list of m's waiting to be freed when their
+	// m.exited is set. Linked through m.freelink.
+	FreeM *M
+
+	GCWaiting bool // gc is waiting to run
+	StopWait  int32
+}
+
+type StkFrame struct {
+	// fn is the function being run in this frame. If there is
+	// inlining, this is the outermost function.
+	fn FuncInfo
+
+	// pc is the program counter within fn.
+	//
+	// The meaning of this is subtle:
+	//
+	// - Typically, this frame performed a regular function call
+	//   and this is the return PC (just after the CALL
+	//   instruction). In this case, pc-1 reflects the CALL
+	//   instruction itself and is the correct source of symbolic
+	//   information.
+	//
+	// - If this frame "called" sigpanic, then pc is the
+	//   instruction that panicked, and pc is the correct address
+	//   to use for symbolic information.
+	//
+	// - If this is the innermost frame, then PC is where
+	//   execution will continue, but it may not be the
+	//   instruction following a CALL. This may be from
+	//   cooperative preemption, in which case this is the
+	//   instruction after the call to morestack. Or this may be
+	//   from a signal or an un-started goroutine, in which case
+	//   PC could be any instruction, including the first
+	//   instruction in a function. Conventionally, we use pc-1
+	//   for symbolic information, unless pc == fn.entry(), in
+	//   which case we use pc.
+	pc uintptr
+
+	// continpc is the PC where execution will continue in fn, or
+	// 0 if execution will not continue in this frame.
+	//
+	// This is usually the same as pc, unless this frame "called"
+	// sigpanic, in which case it's either the address of
+	// deferreturn or 0 if this frame will never execute again.
+	//
+	// This is the PC to use to look up GC liveness for this frame.
+	continpc uintptr
+
+	lr   uintptr // program counter at caller aka link register
+	sp   uintptr // stack pointer at pc
+	fp   uintptr // stack pointer at caller aka frame pointer
+	varp uintptr // top of local variables
+	argp uintptr // pointer to function arguments
+}
+
+type Func struct {
+	NotInHeap [0]byte // sys.NotInHeap: Only in static data
+
+	entryOff uint32 // start pc, as offset from moduledata.text/pcHeader.textStart
+	nameOff  int32  // function name, as index into moduledata.funcnametab.
+
+	args        int32  // in/out args size
+	deferreturn uint32 // offset of start of a deferreturn call instruction from entry, if any.
+
+	pcsp      uint32
+	pcfile    uint32
+	pcln      uint32
+	npcdata   uint32
+	cuOffset  uint32  // runtime.cutab offset of this function's CU
+	startLine int32   // line number of start of function (func keyword/TEXT directive)
+	funcID    [1]byte // abi.FuncID: set for certain special runtime functions
+	flag      [2]byte // abi.FuncFlag
+	nfuncdata uint8   // must be last, must end on a uint32-aligned boundary
+}
+
+type GTraceState struct {
+	TraceSchedResourceState
+}
+
+type MWaitList struct {
+	Next       Muintptr // next m waiting for lock
+	StartTicks int64    // when this m started waiting for the current lock holder, in cputicks
+}
+
+type LockProfile struct {
+	WaitTime   atomic.Int64 // (nanotime) total time this M has spent waiting in runtime.lockWithRank. Read by runtime/metrics.
+	Stack      []uintptr    // call stack at the point of this M's unlock call, when other Ms had to wait
+	Cycles     int64        // (cputicks) cycles attributable to "stack"
+	CyclesLost int64        // (cputicks) contention for which we weren't able to record a call stack
+	HaveStack  bool         // stack and cycles are to be added to the mutex profile (even if cycles is 0)
+	Disabled   bool         // attribute all time to "lost"
+}
+
+type MTraceState struct {
+	Seqlock       uintptr    // seqlock indicating that this M is writing to a trace buffer.
+	Buf           [4]uintptr // [2][tracev2.NumExperiments]*traceBuf: Per-M traceBuf for writing. Indexed by trace.gen%2.
+	Link          *M         // Snapshot of alllink or freelink.
+	Reentered     uint32     // Whether we've reentered tracing from within tracing.
+	Oldthrowsplit bool       // gp.throwsplit upon calling traceLocker.writer. For debugging.
+}
+
+type PcValueCache struct {
+	Entries [2][8]PcvalueCacheEnt
+	InUse   int
+}
+
+type DlogPerM struct {
+}
+
+type MOS struct {
+	// profileTimer holds the ID of the POSIX interval timer for profiling CPU
+	// usage on this thread.
+	//
+	// It is valid when the profileTimerValid field is true. A thread
+	// creates and manages its own timer, and these fields are read and written
+	// only by this thread. But because some of the reads on profileTimerValid
+	// are in signal handling code, this field should be atomic type.
+	ProfileTimer      int32
+	ProfileTimerValid bool
+
+	// needPerThreadSyscall indicates that a per-thread syscall is required
+	// for doAllThreadsSyscall.
+	NeedPerThreadSyscall uint8
+
+	// This is a pointer to a chunk of memory allocated with a special
+	// mmap invocation in vgetrandomGetState().
+	VgetrandomState uintptr
+
+	Waitsema uint32 // semaphore for parking on locks
+}
+
+type HeldLockInfo struct {
+	LockAddr uintptr
+	Rank     int
+}
+
+type SysmonTick struct {
+	SchedTick   uint32
+	SysCallTick uint32
+	SchedWhen   int64
+	SysCallWhen int64
+}
+
+type PageCache struct {
+	Base  uintptr // base address of the chunk
+	Cache uint64  // 64-bit bitmap representing free pages (1 means free)
+	Scav  uint64  // 64-bit bitmap representing scavenged pages (1 means scavenged)
+}
+
+type GQueue struct {
+	Head Guintptr
+	Tail Guintptr
+	Size int32
+}
+
+type GList struct {
+	Head Guintptr
+	Size int32
+}
+
+type FuncInfo struct {
+	Func  *Func
+	Datap unsafe.Pointer
+}
+
+type TraceSchedResourceState struct {
+	// statusTraced indicates whether a status event was traced for this resource
+	// a particular generation.
+	//
+	// There are 3 of these because when transitioning across generations, traceAdvance
+	// needs to be able to reliably observe whether a status was traced for the previous
+	// generation, while we need to clear the value for the next generation.
+	StatusTraced [3]uint32
+
+	// seq is the sequence counter for this scheduling resource's events.
+	// The purpose of the sequence counter is to establish a partial order between
+	// events that don't obviously happen serially (same M) in the stream ofevents.
+	//
+	// There are two of these so that we can reset the counter on each generation.
+	// This saves space in the resulting trace by keeping the counter small and allows
+	// GoStatus and GoCreate events to omit a sequence number (implicitly 0).
+	Seq [2]uint64
+}
+
+type PcvalueCacheEnt struct {
+	// targetpc and off together are the key of this cache entry.
+	Targetpc uintptr
+	Off      uint32
+
+	Val   int32   // The value of this entry.
+	ValPC uintptr // The PC at which val starts
+}
+
+// A waitReason explains why a goroutine has been stopped.
+// See gopark. Do not re-use waitReasons, add new ones.
+const (
+	WaitReasonZero                  WaitReason = iota // ""
+	WaitReasonGCAssistMarking                         // "GC assist marking"
+	WaitReasonIOWait                                  // "IO wait"
+	WaitReasonChanReceiveNilChan                      // "chan receive (nil chan)"
+	WaitReasonChanSendNilChan                         // "chan send (nil chan)"
+	WaitReasonDumpingHeap                             // "dumping heap"
+	WaitReasonGarbageCollection                       // "garbage collection"
+	WaitReasonGarbageCollectionScan                   // "garbage collection scan"
+	WaitReasonPanicWait                               // "panicwait"
+	WaitReasonSelect                                  // "select"
+	WaitReasonSelectNoCases                           // "select (no cases)"
+	WaitReasonGCAssistWait                            // "GC assist wait"
+	WaitReasonGCSweepWait                             // "GC sweep wait"
+	WaitReasonGCScavengeWait                          // "GC scavenge wait"
+	WaitReasonChanReceive                             // "chan receive"
+	WaitReasonChanSend                                // "chan send"
+	WaitReasonFinalizerWait                           // "finalizer wait"
+	WaitReasonForceGCIdle                             // "force gc (idle)"
+	WaitReasonUpdateGOMAXPROCSIdle                    // "GOMAXPROCS updater (idle)"
+	WaitReasonSemacquire                              // "semacquire"
+	WaitReasonSleep                                   // "sleep"
+	WaitReasonSyncCondWait                            // "sync.Cond.Wait"
+	WaitReasonSyncMutexLock                           // "sync.Mutex.Lock"
+	WaitReasonSyncRWMutexRLock                        // "sync.RWMutex.RLock"
+	WaitReasonSyncRWMutexLock                         // "sync.RWMutex.Lock"
+	WaitReasonSyncWaitGroupWait                       // "sync.WaitGroup.Wait"
+	WaitReasonTraceReaderBlocked                      // "trace reader (blocked)"
+	WaitReasonWaitForGCCycle                          // "wait for GC cycle"
+	WaitReasonGCWorkerIdle                            // "GC worker (idle)"
+	WaitReasonGCWorkerActive                          // "GC worker (active)"
+	WaitReasonPreempted                               // "preempted"
+	WaitReasonDebugCall                               // "debug call"
+	WaitReasonGCMarkTermination                       // "GC mark termination"
+	WaitReasonStoppingTheWorld                        // "stopping the world"
+	WaitReasonFlushProcCaches                         // "flushing proc caches"
+	WaitReasonTraceGoroutineStatus                    // "trace goroutine status"
+	WaitReasonTraceProcStatus                         // "trace proc status"
+	WaitReasonPageTraceFlush                          // "page trace flush"
+	WaitReasonCoroutine                               // "coroutine"
+	WaitReasonGCWeakToStrongWait                      // "GC weak to strong wait"
+	WaitReasonSynctestRun                             // "synctest.Run"
+	WaitReasonSynctestWait                            // "synctest.Wait"
+	WaitReasonSynctestChanReceive                     // "chan receive (durable)"
+	WaitReasonSynctestChanSend                        // "chan send (durable)"
+	WaitReasonSynctestSelect                          // "select (durable)"
+	WaitReasonSynctestWaitGroupWait                   // "sync.WaitGroup.Wait (durable)"
+	WaitReasonCleanupWait                             // "cleanup wait"
+)
+
+var WaitReasonStrings = [...]string{
+	WaitReasonZero:                  "",
+	WaitReasonGCAssistMarking:       "GC assist marking",
+	WaitReasonIOWait:                "IO wait",
+	WaitReasonChanReceiveNilChan:    "chan receive (nil chan)",
+	WaitReasonChanSendNilChan:       "chan send (nil chan)",
+	WaitReasonDumpingHeap:           "dumping heap",
+	WaitReasonGarbageCollection:     "garbage collection",
+	WaitReasonGarbageCollectionScan: "garbage collection scan",
+	WaitReasonPanicWait:             "panicwait",
+	WaitReasonSelect:                "select",
+	WaitReasonSelectNoCases:         "select (no cases)",
+	WaitReasonGCAssistWait:          "GC assist wait",
+	WaitReasonGCSweepWait:           "GC sweep wait",
+	WaitReasonGCScavengeWait:        "GC scavenge wait",
+	WaitReasonChanReceive:           "chan receive",
+	WaitReasonChanSend:              "chan send",
+	WaitReasonFinalizerWait:         "finalizer wait",
+	WaitReasonForceGCIdle:           "force gc (idle)",
+	WaitReasonUpdateGOMAXPROCSIdle:  "GOMAXPROCS updater (idle)",
+	WaitReasonSemacquire:            "semacquire",
+	WaitReasonSleep:                 "sleep",
+	WaitReasonSyncCondWait:          "sync.Cond.Wait",
+	WaitReasonSyncMutexLock:         "sync.Mutex.Lock",
+	WaitReasonSyncRWMutexRLock:      "sync.RWMutex.RLock",
+	WaitReasonSyncRWMutexLock:       "sync.RWMutex.Lock",
+	WaitReasonSyncWaitGroupWait:     "sync.WaitGroup.Wait",
+	WaitReasonTraceReaderBlocked:    "trace reader (blocked)",
+	WaitReasonWaitForGCCycle:        "wait for GC cycle",
+	WaitReasonGCWorkerIdle:          "GC worker (idle)",
+	WaitReasonGCWorkerActive:        "GC worker (active)",
+	WaitReasonPreempted:             "preempted",
+	WaitReasonDebugCall:             "debug call",
+	WaitReasonGCMarkTermination:     "GC mark termination",
+	WaitReasonStoppingTheWorld:      "stopping the world",
+	WaitReasonFlushProcCaches:       "flushing proc caches",
+	WaitReasonTraceGoroutineStatus:  "trace goroutine status",
+	WaitReasonTraceProcStatus:       "trace proc status",
+	WaitReasonPageTraceFlush:        "page trace flush",
+	WaitReasonCoroutine:             "coroutine",
+	WaitReasonGCWeakToStrongWait:    "GC weak to strong wait",
+	WaitReasonSynctestRun:           "synctest.Run",
+	WaitReasonSynctestWait:          "synctest.Wait",
+	WaitReasonSynctestChanReceive:   "chan receive (durable)",
+	WaitReasonSynctestChanSend:      "chan send (durable)",
+	WaitReasonSynctestSelect:        "select (durable)",
+	WaitReasonSynctestWaitGroupWait: "sync.WaitGroup.Wait (durable)",
+	WaitReasonCleanupWait:           "cleanup wait",
+}
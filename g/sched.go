@@ -0,0 +1,48 @@
+package g
+
+import (
+	_ "unsafe"
+)
+
+// sched is the runtime scheduler state. Only the leading fields of it
+// are mirrored, see SchedT.
+//
+//go:linkname sched runtime.sched
+var sched SchedT
+
+// SchedStats is a copy of the global scheduler counters of runtime.sched.
+type SchedStats struct {
+	GoIDGen    uint64 // last goroutine id handed out to the Ps
+	RunqSize   int32  // length of the global run queue
+	NPIdle     int32  // number of idle Ps
+	NMSpinning int32  // number of Ms looking for work
+	NMIdle     int32  // number of idle Ms waiting for work
+	NMSys      int32  // number of system Ms not counted for deadlock
+	GCWaiting  bool   // gc is waiting to run
+	StopWait   int32  // number of Ps stop the world is still waiting for
+}
+
+// SchedSnapshot copies the scheduler counters under sched.lock. It
+// returns false if the layouts failed Verify.
+//
+// sched.lock is the hottest lock of the runtime, don't poll it in a
+// tight loop.
+func SchedSnapshot() (SchedStats, bool) {
+	if !Verified() {
+		return SchedStats{}, false
+	}
+
+	Lock(&sched.Lock)
+	s := SchedStats{
+		GoIDGen:    sched.goIDGen(),
+		RunqSize:   sched.runqSize(),
+		NPIdle:     int32(sched.NPIdle),
+		NMSpinning: int32(sched.NMSpinning),
+		NMIdle:     sched.NMIdle,
+		NMSys:      sched.NMSys,
+		GCWaiting:  sched.gcWaiting(),
+		StopWait:   sched.StopWait,
+	}
+	Unlock(&sched.Lock)
+	return s, true
+}
@@ -0,0 +1,8 @@
+//go:build go1.20 && !go1.25
+// +build go1.20,!go1.25
+
+package g
+
+func (s *SchedT) goIDGen() uint64 { return s.GoIDGen.Load() }
+func (s *SchedT) runqSize() int32 { return s.RunqSize }
+func (s *SchedT) gcWaiting() bool { return s.GCWaiting }
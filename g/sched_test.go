@@ -0,0 +1,24 @@
+package g
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestSchedSnapshot(t *testing.T) {
+	s, ok := SchedSnapshot()
+	if !ok {
+		t.Fatal("SchedSnapshot() is disabled:", VerifyError())
+	}
+	if id := uint64(CurG().GoID); s.GoIDGen < id {
+		t.Errorf("GoIDGen = %d, want >= current goid %d", s.GoIDGen, id)
+	}
+	n := int32(runtime.GOMAXPROCS(0))
+	if s.NPIdle < 0 || s.NPIdle >= n {
+		t.Errorf("NPIdle = %d, want in [0, %d)", s.NPIdle, n)
+	}
+	if s.RunqSize < 0 || s.NMSpinning < 0 || s.NMIdle < 0 || s.NMSys < 0 {
+		t.Errorf("negative counters: %+v", s)
+	}
+	t.Logf("%+v", s)
+}
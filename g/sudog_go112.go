@@ -0,0 +1,14 @@
+//go:build !go1.26
+// +build !go1.26
+
+package g
+
+import (
+	"unsafe"
+)
+
+// Elem returns the data element of the sudog, which may point to a stack.
+func (s *Sudog) Elem() unsafe.Pointer { return s.elem }
+
+// C returns the channel the sudog waits on.
+func (s *Sudog) C() *HChan { return s.c }
@@ -0,0 +1,19 @@
+//go:build go1.26
+// +build go1.26
+
+package g
+
+import (
+	"unsafe"
+)
+
+// Get returns the pointer, Vu is the source of truth.
+func (p *MaybeTraceablePtr) Get() unsafe.Pointer {
+	return *(*unsafe.Pointer)(unsafe.Pointer(&p.Vu))
+}
+
+// Elem returns the data element of the sudog, which may point to a stack.
+func (s *Sudog) Elem() unsafe.Pointer { return s.elem.Get() }
+
+// C returns the channel the sudog waits on.
+func (s *Sudog) C() *HChan { return (*HChan)(s.c.Get()) }
@@ -0,0 +1,11 @@
+//go:build !go1.20 || go1.28 || !linux
+// +build !go1.20 go1.28 !linux
+
+package g
+
+// The runtime layouts are only known for the releases that have a
+// runtime2_go1*.go file, and only for linux: the go_asm headers are
+// generated for it and the M mirror embeds its mOS. Refuse to build for
+// any other toolchain or OS instead of silently reading garbage through
+// mismatched offsets.
+var _ = unsupportedGoVersionOrOS_see_runtime2_go1xx_files
@@ -0,0 +1,183 @@
+package g
+
+import (
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"unsafe"
+)
+
+// disabled is the unsafe accessors kill switch. It is flipped by Verify
+// once the mirrored layouts are found not to match the running runtime.
+var disabled uint32
+
+// verifyErr keeps the outcome of the last Verify.
+var verifyErr atomic.Value // of verifyResult
+
+type verifyResult struct{ err error }
+
+// LayoutError reports a mismatch between the mirrored runtime structures
+// and the runtime the program is running on.
+type LayoutError struct {
+	Version string // runtime.Version()
+	Check   string // name of the failed check
+	Got     interface{}
+	Want    interface{}
+}
+
+func (e *LayoutError) Error() string {
+	return fmt.Sprintf("g: %s %s/%s layout mismatch: %s: got %v, want %v",
+		e.Version, runtime.GOOS, runtime.GOARCH, e.Check, e.Got, e.Want)
+}
+
+// asmLayout returns the offsets the assembly stubs were built with
+// (the go_asm_go1*_<arch>.h header of the compiling release).
+func asmLayout() (gSize, gM, gGoID, gAtomicStatus, gLockedM, mSize, mG0, mCurG, mLockedG uintptr)
+
+// asmOffsets is the asmLayout verify checks the mirrors against. The
+// tests replace it to trip the kill switch.
+var asmOffsets = asmLayout
+
+func init() {
+	Verify()
+}
+
+// Verify checks the mirrored G and M layouts against the go_asm header
+// the stubs were built with and against the invariants of the running
+// goroutine (gp.m.curg == gp, m.g0 != nil, m.p.m == m, goid, status,
+// stack bounds, ...).
+//
+// Verify runs once at init. If it fails, CurG and CurM return nil from
+// then on instead of handing out pointers into misread memory. A later
+// successful Verify turns them back on.
+func Verify() error {
+	err := verify()
+	if err != nil {
+		atomic.StoreUint32(&disabled, 1)
+	} else {
+		atomic.StoreUint32(&disabled, 0)
+	}
+	verifyErr.Store(verifyResult{err})
+	return err
+}
+
+// Verified reports whether the last Verify succeeded, that is whether
+// CurG and CurM are enabled.
+func Verified() bool {
+	return atomic.LoadUint32(&disabled) == 0
+}
+
+// VerifyError returns the error of the last Verify, or nil.
+func VerifyError() error {
+	r, _ := verifyErr.Load().(verifyResult)
+	return r.err
+}
+
+func verify() error {
+	fail := func(check string, got, want interface{}) error {
+		return &LayoutError{Version: runtime.Version(), Check: check, Got: got, Want: want}
+	}
+
+	gSize, gM, gGoID, gAtomicStatus, gLockedM, mSize, mG0, mCurG, mLockedG := asmOffsets()
+	for _, c := range []struct {
+		name      string
+		got, want uintptr
+	}{
+		{"g__size", unsafe.Sizeof(G{}), gSize},
+		{"g_m", unsafe.Offsetof(G{}.M), gM},
+		{"g_goid", unsafe.Offsetof(G{}.GoID), gGoID},
+		{"g_atomicstatus", unsafe.Offsetof(G{}.AtomicStatus), gAtomicStatus},
+		{"g_lockedm", unsafe.Offsetof(G{}.LockedM), gLockedM},
+		{"m__size", unsafe.Sizeof(M{}), mSize},
+		{"m_g0", unsafe.Offsetof(M{}.G0), mG0},
+		{"m_curg", unsafe.Offsetof(M{}.CurG), mCurG},
+		{"m_lockedg", unsafe.Offsetof(M{}.LockedG), mLockedG},
+	} {
+		if c.got != c.want {
+			return fail(c.name, c.got, c.want)
+		}
+	}
+
+	// Pin the goroutine to its M so gp.m can not change under us.
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	gp := (*G)(GetG())
+	mp := (*M)(GetM())
+	if gp == nil || mp == nil {
+		return fail("getg", gp, "non-nil")
+	}
+	if gp.M != mp {
+		return fail("g.m", unsafe.Pointer(gp.M), unsafe.Pointer(mp))
+	}
+	if mp.CurG != gp {
+		return fail("g.m.curg", unsafe.Pointer(mp.CurG), unsafe.Pointer(gp))
+	}
+	if mp.G0 == nil || mp.G0 == gp || mp.G0.M != mp {
+		return fail("m.g0.m", unsafe.Pointer(mp.G0), "g0 of m")
+	}
+	if mp.LockedG.Ptr() != gp {
+		return fail("m.lockedg", unsafe.Pointer(mp.LockedG.Ptr()), unsafe.Pointer(gp))
+	}
+	if gp.LockedM.Ptr() != mp {
+		return fail("g.lockedm", unsafe.Pointer(gp.LockedM.Ptr()), unsafe.Pointer(mp))
+	}
+	// A preemption can hand the P off while we look at it, so give it
+	// a few tries before calling it a mismatch. The goroutine is locked
+	// to mp, yielding gets it back on mp with a P.
+	var pp *P
+	for i := 0; i < 3; i++ {
+		if i > 0 {
+			runtime.Gosched()
+		}
+		pp = mp.P.Ptr()
+		if pp != nil && pp.M.Ptr() == mp && pp.Status == PRunning {
+			break
+		}
+	}
+	if pp == nil || pp.M.Ptr() != mp {
+		return fail("m.p.m", unsafe.Pointer(pp), "p of m")
+	}
+	if pp.Status != PRunning {
+		return fail("m.p.status", pp.Status, PRunning)
+	}
+	if n := runtime.GOMAXPROCS(0); pp.ID < 0 || int(pp.ID) >= n {
+		return fail("m.p.id", pp.ID, fmt.Sprintf("in [0, %d)", n))
+	}
+	if s := gp.Status(); s != GRunning {
+		return fail("g.atomicstatus", s, GRunning)
+	}
+
+	var local byte
+	sp := uintptr(unsafe.Pointer(&local))
+	if sp < gp.Stack.lo || sp >= gp.Stack.hi {
+		return fail("g.stack", fmt.Sprintf("[%#x, %#x)", gp.Stack.lo, gp.Stack.hi), fmt.Sprintf("contains %#x", sp))
+	}
+
+	id, ok := stackGoID()
+	if ok && uint64(gp.GoID) != id {
+		return fail("g.goid", gp.GoID, id)
+	}
+
+	// Goroutine ids are handed out to the Ps in batches from sched.goidgen.
+	if gen := sched.goIDGen(); uint64(gp.GoID) > gen {
+		return fail("sched.goidgen", gen, fmt.Sprintf(">= goid %d", gp.GoID))
+	}
+
+	return nil
+}
+
+// stackGoID reads the current goroutine id from the header of its stack
+// trace: "goroutine 18 [running]:".
+func stackGoID() (uint64, bool) {
+	var buf [64]byte
+	s := string(buf[:runtime.Stack(buf[:], false)])
+	s = strings.TrimPrefix(s, "goroutine ")
+	if i := strings.IndexByte(s, ' '); i > 0 {
+		id, err := strconv.ParseUint(s[:i], 10, 64)
+		return id, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,88 @@
+package g
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	if err := VerifyError(); err != nil {
+		t.Fatal("init:", err)
+	}
+	if err := Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if !Verified() || CurG() == nil || CurM() == nil {
+		t.Fatal("unsafe accessors are disabled after successful Verify")
+	}
+}
+
+func TestVerifyGoroutines(t *testing.T) {
+	var wg sync.WaitGroup
+	errs := make(chan error, 64)
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- Verify()
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestLayoutError(t *testing.T) {
+	err := &LayoutError{Version: "go1.0", Check: "g_goid", Got: 1, Want: 2}
+	if s := err.Error(); s == "" {
+		t.Fatal("empty error")
+	}
+}
+
+func TestVerifyKillSwitch(t *testing.T) {
+	defer func() {
+		asmOffsets = asmLayout
+		if err := Verify(); err != nil {
+			t.Fatal("restore:", err)
+		}
+	}()
+	asmOffsets = func() (gSize, gM, gGoID, gAtomicStatus, gLockedM, mSize, mG0, mCurG, mLockedG uintptr) {
+		gSize, gM, gGoID, gAtomicStatus, gLockedM, mSize, mG0, mCurG, mLockedG = asmLayout()
+		return gSize, gM, gGoID + 8, gAtomicStatus, gLockedM, mSize, mG0, mCurG, mLockedG
+	}
+
+	err := Verify()
+	if le, ok := err.(*LayoutError); !ok || le.Check != "g_goid" {
+		t.Fatalf("Verify() = %v, want the g_goid *LayoutError", err)
+	}
+	if le, ok := VerifyError().(*LayoutError); !ok || le.Check != "g_goid" {
+		t.Fatalf("VerifyError() = %v, want the g_goid *LayoutError", VerifyError())
+	}
+	if Verified() {
+		t.Fatal("Verified after a failed Verify")
+	}
+	if CurG() != nil || CurM() != nil || CurP() != nil {
+		t.Fatal("CurG, CurM or CurP is enabled after a failed Verify")
+	}
+	if gs := AllGs(); gs != nil {
+		t.Fatalf("AllGs returned %d goroutines after a failed Verify", len(gs))
+	}
+	if _, ok := SchedSnapshot(); ok {
+		t.Fatal("SchedSnapshot is enabled after a failed Verify")
+	}
+
+	asmOffsets = asmLayout
+	if err := Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if !Verified() || VerifyError() != nil || CurG() == nil || CurM() == nil {
+		t.Fatal("unsafe accessors are disabled after a good Verify")
+	}
+	if _, ok := SchedSnapshot(); !ok {
+		t.Fatal("SchedSnapshot is disabled after a good Verify")
+	}
+}
@@ -5,14 +5,29 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+
+	"github.com/sitano/gsysint/g"
 )
 
 var ErrWrongFormat = errors.New("wrong stack format")
 
+// GoID returns id of invoker goroutine.
+//
+// It reads the current g directly if the runtime layouts passed
+// g.Verify for this toolchain, and falls back to GIDFromStackTrace
+// otherwise. It returns 0 if both fail.
+func GoID() uint64 {
+	if gp := g.CurG(); gp != nil {
+		return uint64(gp.GoID)
+	}
+	id, _ := GIDFromStackTrace()
+	return id
+}
+
 // GIDFromStackTrace returns id of invoker goroutine.
 func GIDFromStackTrace() (uint64, error) {
-	buf := make([]byte, 64)
-	sz := runtime.Stack(buf, false)
+	var buf [64]byte
+	sz := runtime.Stack(buf[:], false)
 	sp := strings.Split(string(buf[:sz]), " ")
 	if len(sp) < 2 {
 		return 0, ErrWrongFormat
@@ -11,8 +11,31 @@ func TestGIDFromStack(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	id2 := g.CurG().GoID
-	if id != id {
+	id2 := uint64(g.CurG().GoID)
+	if id != id2 {
 		t.Error("ids are different", id, id2)
 	}
 }
+
+func BenchmarkGoID(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		GoID()
+	}
+}
+
+func BenchmarkCurGGoID(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = g.CurG().GoID
+	}
+}
+
+func BenchmarkGIDFromStackTrace(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := GIDFromStackTrace(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+// Package gls provides goroutine local storage keyed by the runtime G of
+// the calling goroutine.
+//
+// A goroutine is identified by its G pointer and goroutine id together,
+// as the runtime reuses the G of exited goroutines for new ones. The
+// values of a goroutine are dropped once it is seen dead: its G is
+// _Gdead or runs a goroutine with another id. The store is swept every
+// time it doubles in size, so it stays proportional to the number of
+// live goroutines that have values.
+//
+// The storage relies on g.CurG. If the runtime layouts failed g.Verify,
+// it is disabled: Get finds nothing and Set drops the value.
+package gls
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/sitano/gsysint/g"
+)
+
+// gid identifies a goroutine, see the package doc.
+type gid struct {
+	gp *g.G
+	id uint64
+}
+
+func current() (gid, bool) {
+	gp := g.CurG()
+	if gp == nil {
+		return gid{}, false
+	}
+	return gid{gp, uint64(gp.GoID)}, true
+}
+
+// dead reports whether the goroutine has exited.
+func (k gid) dead() bool {
+	if s := k.gp.Status().Base(); s == g.GDead || s == g.GDeadExtra {
+		return true
+	}
+	return uint64(k.gp.GoID) != k.id
+}
+
+const (
+	nshards = 64
+	// sweepMin is the shard size below which it is not swept.
+	sweepMin = 64
+)
+
+type shard struct {
+	mu     sync.Mutex
+	values map[gid]map[interface{}]interface{}
+	sweep  int // size to sweep at
+}
+
+var shards [nshards]shard
+
+func shardOf(k gid) *shard {
+	// Gs are allocated from size classes, drop the low bits that are
+	// the same for all of them.
+	return &shards[(uintptr(unsafe.Pointer(k.gp))>>6)%nshards]
+}
+
+// Get returns the value stored for key by the calling goroutine.
+func Get(key interface{}) (interface{}, bool) {
+	k, ok := current()
+	if !ok {
+		return nil, false
+	}
+	s := shardOf(k)
+	s.mu.Lock()
+	v, ok := s.values[k][key]
+	s.mu.Unlock()
+	return v, ok
+}
+
+// Set stores value for key in the calling goroutine.
+func Set(key, value interface{}) {
+	k, ok := current()
+	if !ok {
+		return
+	}
+	s := shardOf(k)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.values == nil {
+		s.values = make(map[gid]map[interface{}]interface{})
+	}
+	m := s.values[k]
+	if m == nil {
+		if len(s.values) >= s.sweep {
+			s.sweepLocked()
+		}
+		m = make(map[interface{}]interface{})
+		s.values[k] = m
+	}
+	m[key] = value
+}
+
+// Delete removes the value stored for key by the calling goroutine.
+func Delete(key interface{}) {
+	k, ok := current()
+	if !ok {
+		return
+	}
+	s := shardOf(k)
+	s.mu.Lock()
+	if m := s.values[k]; m != nil {
+		delete(m, key)
+		if len(m) == 0 {
+			delete(s.values, k)
+		}
+	}
+	s.mu.Unlock()
+}
+
+// Clear removes all the values of the calling goroutine.
+func Clear() {
+	k, ok := current()
+	if !ok {
+		return
+	}
+	s := shardOf(k)
+	s.mu.Lock()
+	delete(s.values, k)
+	s.mu.Unlock()
+}
+
+// Sweep drops the values of the goroutines that have exited. It is done
+// on the go by Set, calling it is only needed to release the memory
+// early.
+func Sweep() {
+	for i := range shards {
+		s := &shards[i]
+		s.mu.Lock()
+		s.sweepLocked()
+		s.mu.Unlock()
+	}
+}
+
+func (s *shard) sweepLocked() {
+	for k := range s.values {
+		if k.dead() {
+			delete(s.values, k)
+		}
+	}
+	s.sweep = 2*len(s.values) + sweepMin
+}
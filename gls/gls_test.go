@@ -0,0 +1,115 @@
+package gls
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+)
+
+type ctxKey struct{}
+
+func tracked() int {
+	n := 0
+	for i := range shards {
+		s := &shards[i]
+		s.mu.Lock()
+		n += len(s.values)
+		s.mu.Unlock()
+	}
+	return n
+}
+
+func TestGetSetDelete(t *testing.T) {
+	defer Clear()
+
+	if _, ok := Get(ctxKey{}); ok {
+		t.Fatal("value before Set")
+	}
+	Set(ctxKey{}, "request-1")
+	if v, ok := Get(ctxKey{}); !ok || v != "request-1" {
+		t.Fatalf("Get = %v, %v", v, ok)
+	}
+	Set(ctxKey{}, "request-2")
+	if v, _ := Get(ctxKey{}); v != "request-2" {
+		t.Fatalf("Get after second Set = %v", v)
+	}
+	Delete(ctxKey{})
+	if _, ok := Get(ctxKey{}); ok {
+		t.Fatal("value after Delete")
+	}
+}
+
+func TestIsolation(t *testing.T) {
+	defer Clear()
+	Set(ctxKey{}, -1)
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 100)
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if _, ok := Get(ctxKey{}); ok {
+				errs <- "value inherited from another goroutine"
+				return
+			}
+			Set(ctxKey{}, i)
+			for j := 0; j < 100; j++ {
+				if v, _ := Get(ctxKey{}); v != i {
+					errs <- "value of another goroutine"
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+	if v, _ := Get(ctxKey{}); v != -1 {
+		t.Fatalf("own value changed to %v", v)
+	}
+}
+
+func TestSweep(t *testing.T) {
+	Sweep()
+	before := tracked()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 1000; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			Set(ctxKey{}, i)
+		}(i)
+	}
+	wg.Wait()
+
+	// The goroutines may still be on their way out after Done.
+	for try := 0; ; try++ {
+		Sweep()
+		n := tracked()
+		if n <= before {
+			break
+		}
+		if try == 1000 {
+			t.Fatalf("%d goroutines tracked after sweep, want %d", n, before)
+		}
+		runtime.Gosched()
+	}
+}
+
+func TestBounded(t *testing.T) {
+	for i := 0; i < 10000; i++ {
+		done := make(chan struct{})
+		go func() {
+			Set(ctxKey{}, i)
+			close(done)
+		}()
+		<-done
+	}
+	if n, max := tracked(), nshards*2*(sweepMin+1); n > max {
+		t.Fatalf("%d goroutines tracked, want at most %d", n, max)
+	}
+}
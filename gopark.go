@@ -1,19 +1,113 @@
 package gsysint
 
 import (
+	"context"
+	"fmt"
 	"sync/atomic"
+	"time"
 	"unsafe"
 
 	"github.com/sitano/gsysint/trace"
 	"github.com/sitano/gsysint/g"
 )
 
+// Park parks and readies one goroutine with permit semantics, like
+// thread::park/unpark: Ready leaves a permit that makes the next park
+// return at once, if the goroutine is not parked yet, and is a no-op if
+// the permit is already there. Only the goroutine that owns p parks on it,
+// any goroutine may call Ready.
 type Park struct {
-	g unsafe.Pointer
+	g     unsafe.Pointer
+	state uint32   // phase, flags and generation, see parkPhase
+	lock  *g.Mutex // unlocked once the goroutine is parked
+
+	vlock g.Mutex        // serializes ReadyWith
+	val   unsafe.Pointer // the value of ReadyWith, see parkValue
+	recv  unsafe.Pointer // the value taken by the last park
+
+	reason  g.WaitReason // shown in the goroutine dumps
+	traceEv byte         // gopark trace argument, see trace.BlockReason
+}
+
+// ParkOption configures a Park made by NewPark.
+type ParkOption func(*Park) error
+
+// WithWaitReason makes the parked goroutine show as waiting for reason in
+// the goroutine dumps and profiles, like g.WaitReasonSyncCondWait.
+func WithWaitReason(reason g.WaitReason) ParkOption {
+	return func(p *Park) error {
+		if !reason.Valid() {
+			return fmt.Errorf("gsysint: wait reason %d is not in g.WaitReasonStrings", reason)
+		}
+		p.reason = reason
+		return nil
+	}
+}
+
+// WithTraceEvent makes the park emit the block event ev to the execution
+// trace, like trace.TraceEvGoBlockSync.
+func WithTraceEvent(ev byte) ParkOption {
+	return func(p *Park) error {
+		if !trace.IsBlockEvent(ev) {
+			return fmt.Errorf("gsysint: trace event %d is not a block event", ev)
+		}
+		p.traceEv = trace.BlockReason(ev)
+		return nil
+	}
+}
+
+// ParkResult tells why a park returned.
+type ParkResult uint32
+
+const (
+	ParkWoken     ParkResult = iota + 1 // by Ready, or a permit
+	ParkTimedOut                        // by the timer of ParkTimeout
+	ParkCancelled                       // by the context of ParkContext
+)
+
+var parkResultStrings = [...]string{
+	ParkWoken:     "woken",
+	ParkTimedOut:  "timed out",
+	ParkCancelled: "cancelled",
 }
 
-func NewPark() Park {
-	return Park{}
+func (r ParkResult) String() string {
+	if int(r) < len(parkResultStrings) && parkResultStrings[r] != "" {
+		return parkResultStrings[r]
+	}
+	return "unknown park result"
+}
+
+// Park.state phases besides the results. A park goes from parkEmpty to
+// parkArmed, to parkWaiting once the goroutine is parked, to the result
+// set by the first waker, and back to parkEmpty when it returns. Between
+// the parks ParkWoken is the permit. ReadyWith sets parkValue with
+// ParkWoken, the park that ends takes val before it empties the state.
+//
+// Every park gets a new generation in the high bits. A timer or a context
+// wakes only the park of the generation it was started for: one that
+// fires as its park returns woken does not end the next one.
+const (
+	parkEmpty   = 0
+	parkArmed   = 4 // park started, not parked yet
+	parkWaiting = 5 // parked, the waker readies the goroutine
+
+	parkPhase = 7      // mask of the phase in the state
+	parkRecv  = 1 << 3 // the park is a ParkRecv, it takes a value
+	parkValue = 1 << 4 // the wake carries the value of ReadyWith in val
+	parkGen   = 1 << 5 // generation increment, the rest of the state
+)
+
+// NewPark returns a Park configured with opts. A zero Park parks with
+// g.WaitReasonZero and trace.TraceEvNone. It panics on an invalid option.
+func NewPark(opts ...ParkOption) Park {
+	var p Park
+	for _, opt := range opts {
+		if err := opt(&p); err != nil {
+			panic(err)
+		}
+	}
+	return p
 }
 
 func (p *Park) Set() {
@@ -24,20 +118,211 @@ func (p *Park) Ptr() unsafe.Pointer {
 	return atomic.LoadPointer(&p.g)
 }
 
-// GoPark puts the current goroutine into a waiting state and calls unlockf.
+// GoPark puts the current goroutine into a waiting state until Ready,
+// or consumes the permit. m is left locked.
 func (p *Park) Park(m *g.Mutex) {
-	GoPark(func(g *g.G, p unsafe.Pointer) bool { return true }, unsafe.Pointer(m), g.WaitReasonZero, trace.TraceEvNone, 1)
+	if _, ok := p.arm(0); ok {
+		p.wait(nil)
+	}
 }
 
 // GoParkUnlock puts the current goroutine into a waiting state and unlocks the lock.
-// The goroutine can be made runnable again by calling goready(gp).
+// The goroutine can be made runnable again by calling Ready, before or after
+// the goroutine is parked.
 func (p *Park) ParkUnlock(m *g.Mutex) {
-	GoParkUnlock(m, g.WaitReasonZero, trace.TraceEvNone, 1)
+	if _, ok := p.arm(0); !ok {
+		Unlock(m)
+		return
+	}
+	p.wait(m)
 }
 
-// GoReady marks gp is ready to run.
+// GoReady marks the parked goroutine ready to run, or leaves the permit
+// for its next park. A Ready that races with a timeout or cancellation
+// makes the park return ParkWoken, it never readies the goroutine twice.
 func (p *Park) Ready() {
-	GoReady((*g.G)(p.g), 1)
+	p.ready()
+}
+
+// ready is Ready reporting whether it readied the goroutine, into the
+// runnext slot of the current P.
+func (p *Park) ready() bool {
+	for {
+		s := atomic.LoadUint32(&p.state)
+		switch s & parkPhase {
+		case uint32(ParkWoken):
+			return false
+		case parkWaiting:
+			if atomic.CompareAndSwapUint32(&p.state, s, s&^parkPhase|uint32(ParkWoken)) {
+				GoReady((*g.G)(p.Ptr()), 2)
+				return true
+			}
+		default:
+			if atomic.CompareAndSwapUint32(&p.state, s, s&^parkPhase|uint32(ParkWoken)) {
+				return false
+			}
+		}
+	}
+}
+
+// ParkTimeout parks the current goroutine until Ready is called or d
+// elapses. It is woken by a runtime timer, not by a helper goroutine.
+func (p *Park) ParkTimeout(d time.Duration) ParkResult {
+	if d <= 0 {
+		// Only Ready moves the state between the parks, off parkEmpty.
+		if s := atomic.LoadUint32(&p.state); s&parkPhase == uint32(ParkWoken) {
+			p.take(s)
+			atomic.StoreUint32(&p.state, s&^(parkGen-1))
+			return ParkWoken
+		}
+		return ParkTimedOut
+	}
+	gen, ok := p.arm(0)
+	if !ok {
+		return ParkWoken
+	}
+	t := startParkTimer(p, gen, d, ParkTimedOut)
+	r := p.wait(nil)
+	t.stop()
+	return r
+}
+
+// ParkContext parks the current goroutine until Ready is called or ctx
+// is done. The result is ParkCancelled for a ctx done either way, ctx.Err
+// tells which.
+//
+// A ctx that is never done costs nothing. Otherwise the park is hooked
+// to the cancellation of ctx with context.AfterFunc, which starts no
+// goroutine for the contexts of package context. For other contexts a
+// goroutine waits on ctx.Done for the time of the park.
+//
+// Before go1.21 the deadline of ctx is watched by the runtime timer of
+// ParkTimeout, a cancellation earlier than the deadline ends the park
+// only at the deadline. A goroutine waits on ctx.Done for the contexts
+// with no deadline.
+func (p *Park) ParkContext(ctx context.Context) ParkResult {
+	gen, ok := p.arm(0)
+	if !ok {
+		return ParkWoken
+	}
+	done := ctx.Done()
+	if done == nil {
+		return p.wait(nil)
+	}
+	select {
+	case <-done:
+		if p.end() == ParkWoken {
+			return ParkWoken
+		}
+		return ParkCancelled
+	default:
+	}
+	stop := watchContext(p, gen, ctx)
+	r := p.wait(nil)
+	stop()
+	if r == ParkCancelled {
+		// The timer of the deadline may fire before the one of ctx, ctx.Err
+		// is set by then.
+		<-done
+	}
+	return r
+}
+
+// arm starts a park of the current goroutine in a new generation, from
+// now on a wake is not lost. flags is parkRecv or 0. It returns the
+// generation for the wakes of the park, or false if it consumed the
+// permit instead.
+func (p *Park) arm(flags uint32) (gen uint32, ok bool) {
+	p.Set()
+	for {
+		s := atomic.LoadUint32(&p.state)
+		if s&parkPhase == parkEmpty {
+			gen = s&^(parkGen-1) + parkGen
+			if atomic.CompareAndSwapUint32(&p.state, s, gen|flags|parkArmed) {
+				return gen, true
+			}
+			continue
+		}
+		// Only Ready and ReadyWith move the state off parkEmpty between
+		// the parks, to the permit, which stays until it is consumed.
+		p.take(s)
+		atomic.StoreUint32(&p.state, s&^(parkGen-1))
+		return 0, false
+	}
+}
+
+// wait parks the armed goroutine, unless it has been woken already, and
+// unlocks l. It returns the result of the park and empties the state.
+func (p *Park) wait(l *g.Mutex) ParkResult {
+	p.lock = l
+	GoPark(parkCommit, unsafe.Pointer(p), p.reason, p.traceEv, 2)
+	return p.end()
+}
+
+// end empties the state of the park, keeping its generation, and returns
+// its phase: the result, or parkArmed if no one woke it.
+func (p *Park) end() ParkResult {
+	for {
+		s := atomic.LoadUint32(&p.state)
+		// A result is final, only an armed park may move meanwhile.
+		if s&parkPhase != parkArmed {
+			p.take(s)
+		}
+		if atomic.CompareAndSwapUint32(&p.state, s, s&^(parkGen-1)) {
+			return ParkResult(s & parkPhase)
+		}
+	}
+}
+
+// take moves the value of ReadyWith to recv if the wake s carries it. It
+// runs before the state is emptied: until then ReadyWith leaves val be.
+func (p *Park) take(s uint32) {
+	if s&parkValue != 0 {
+		p.recv = atomic.SwapPointer(&p.val, nil)
+	}
+}
+
+// parkCommit is the gopark unlockf of Park: the goroutine is waiting now,
+// and stays parked unless a wake came first. The lock is released first,
+// a Ready under it then either finds the goroutine armed or parked.
+// It runs on the system stack, which has no race context.
+//
+//go:norace
+func parkCommit(_ *g.G, pp unsafe.Pointer) bool {
+	p := (*Park)(pp)
+	if l := p.lock; l != nil {
+		g.Unlock(l)
+	}
+	s := loadUint32(&p.state)
+	return s&parkPhase == parkArmed && casUint32(&p.state, s, s&^parkPhase|parkWaiting)
+}
+
+// wake ends the park of generation gen with r, if no one else did and it
+// is still the current one. Only the waker that moves the state off
+// parkWaiting readies the goroutine. It runs in the timer callbacks, on
+// the system stack, so it must not block, allocate or be instrumented.
+//
+//go:norace
+func (p *Park) wake(gen uint32, r ParkResult) {
+	for {
+		s := loadUint32(&p.state)
+		if s&^(parkGen-1) != gen {
+			return
+		}
+		switch s & parkPhase {
+		case parkArmed:
+			if casUint32(&p.state, s, s&^parkPhase|uint32(r)) {
+				return
+			}
+		case parkWaiting:
+			if casUint32(&p.state, s, s&^parkPhase|uint32(r)) {
+				GoReady((*g.G)(p.Ptr()), 2)
+				return
+			}
+		default:
+			return
+		}
+	}
 }
 
 // GoPark puts the current goroutine into a waiting state and calls unlockf.
@@ -49,6 +334,7 @@ func (p *Park) Ready() {
 // Reasons should be unique and descriptive.
 // Do not re-use reasons, add new ones.
 // Lock is g.Mutex spin mutex.
+// TraceEv is trace.BlockReason of the block event.
 //go:linkname GoPark runtime.gopark
 func GoPark(unlockf func(*g.G, unsafe.Pointer) bool, lock unsafe.Pointer, reason g.WaitReason, traceEv byte, traceskip int)
 
@@ -2,17 +2,32 @@ package gsysint
 
 import (
 	"bytes"
+	"context"
+	"math/rand"
 	"runtime"
 	"runtime/pprof"
+	rtrace "runtime/trace"
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 	"unsafe"
 
 	"github.com/sitano/gsysint/g"
 	"github.com/sitano/gsysint/trace"
 )
 
+// rawParked waits until the goroutine stored in gp is parked, readying it
+// before throws.
+func rawParked(gp *unsafe.Pointer) {
+	for {
+		if p := atomic.LoadPointer(gp); p != nil && (*g.G)(p).Status() == g.GWaiting {
+			return
+		}
+		runtime.Gosched()
+	}
+}
+
 func TestPark(t *testing.T) {
 	t.Run("raw api park with unlock", func(t *testing.T) {
 		var gp unsafe.Pointer
@@ -29,7 +44,7 @@ func TestPark(t *testing.T) {
 			w.Done()
 		}()
 
-		runtime.Gosched()
+		rawParked(&gp)
 
 		if gp == nil {
 			t.Fatalf("GetG() returned nil pointer to the g structure")
@@ -78,7 +93,7 @@ func TestParkLock(t *testing.T) {
 		}, nil, g.WaitReasonZero, trace.TraceEvNone, 1)
 	}()
 
-	runtime.Gosched()
+	rawParked(&gp)
 
 	stack := &bytes.Buffer{}
 	_ = pprof.Lookup("goroutine").WriteTo(stack, 1)
@@ -86,3 +101,299 @@ func TestParkLock(t *testing.T) {
 
 	GoReady((*g.G)(gp), 1)
 }
+
+// parked waits until the goroutine of p is parked.
+func parked(p *Park) {
+	for atomic.LoadUint32(&p.state)&parkPhase != parkWaiting {
+		runtime.Gosched()
+	}
+}
+
+func TestParkPermit(t *testing.T) {
+	var p Park
+	var m g.Mutex
+
+	// Ready before the park leaves a permit, redundant ones are no-ops.
+	p.Ready()
+	p.Ready()
+	p.Park(&m)
+	Lock(&m)
+	p.Ready()
+	p.ParkUnlock(&m)
+	Lock(&m)
+	Unlock(&m)
+	if r := p.ParkTimeout(time.Millisecond); r != ParkTimedOut {
+		t.Fatalf("got %v with no permit left, want %v", r, ParkTimedOut)
+	}
+	p.Ready()
+	if r := p.ParkTimeout(0); r != ParkWoken {
+		t.Fatalf("got %v with a permit, want %v", r, ParkWoken)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		Lock(&m)
+		p.ParkUnlock(&m)
+		close(done)
+	}()
+	Lock(&m)
+	p.Ready()
+	Unlock(&m)
+	<-done
+}
+
+// TestParkStress runs random interleavings of a parker that parks in
+// random ways until it has seen all the wakes and of the wakers that call
+// Ready after each, and cancel the context of the park. A lost wake hangs
+// it, a double ready throws, a spurious or stale wake fails its result.
+func TestParkStress(t *testing.T) {
+	rounds := 5000
+	if testing.Short() {
+		rounds = 500
+	}
+	var w sync.WaitGroup
+	for i := 0; i < rounds; i++ {
+		rnd := rand.New(rand.NewSource(int64(i)))
+		var p Park
+		var m g.Mutex
+		var n int32
+		wakers, wakes := 1+rnd.Intn(3), 1+rnd.Intn(5)
+		kinds := rand.New(rand.NewSource(rnd.Int63()))
+
+		var cmu sync.Mutex
+		cancel := func() {}
+
+		w.Add(1 + wakers)
+		go func() {
+			defer w.Done()
+			woken := int32(0)
+			for atomic.LoadInt32(&n) < int32(wakers*wakes) {
+				r := ParkWoken
+				switch kinds.Intn(4) {
+				case 0:
+					p.Park(nil)
+				case 1:
+					Lock(&m)
+					p.ParkUnlock(&m)
+				case 2:
+					d := time.Duration(kinds.Intn(100)) * time.Microsecond
+					start := time.Now()
+					if r = p.ParkTimeout(d); r == ParkCancelled || r == ParkTimedOut && time.Since(start) < d {
+						t.Errorf("ParkTimeout(%v) got %v after %v", d, r, time.Since(start))
+					}
+				case 3:
+					ctx, c := context.WithCancel(context.Background())
+					cmu.Lock()
+					cancel = c
+					cmu.Unlock()
+					if r = p.ParkContext(ctx); r == ParkTimedOut || r == ParkCancelled && ctx.Err() == nil {
+						t.Errorf("ParkContext got %v with a live context", r)
+					}
+					c()
+				}
+				// Every return woken takes a Ready, the wakes count them
+				// before the call.
+				if r == ParkWoken {
+					if woken++; woken > atomic.LoadInt32(&n) {
+						t.Errorf("woken %d times by %d wakes", woken, atomic.LoadInt32(&n))
+					}
+				}
+			}
+		}()
+		for j := 0; j < wakers; j++ {
+			delays := make([]int, wakes)
+			for k := range delays {
+				delays[k] = rnd.Intn(3)
+			}
+			how := rnd.Intn(4)
+			go func() {
+				defer w.Done()
+				for _, d := range delays {
+					for ; d > 0; d-- {
+						runtime.Gosched()
+					}
+					if how&1 != 0 {
+						cmu.Lock()
+						c := cancel
+						cmu.Unlock()
+						c()
+					}
+					atomic.AddInt32(&n, 1)
+					if how&2 != 0 {
+						Lock(&m)
+						p.Ready()
+						Unlock(&m)
+					} else {
+						p.Ready()
+					}
+				}
+			}()
+		}
+		if i%100 == 99 {
+			w.Wait()
+		}
+	}
+	w.Wait()
+}
+
+func TestParkTimeout(t *testing.T) {
+	t.Run("timeout", func(t *testing.T) {
+		var p Park
+		start := time.Now()
+		if r := p.ParkTimeout(10 * time.Millisecond); r != ParkTimedOut {
+			t.Fatalf("got %v, want %v", r, ParkTimedOut)
+		}
+		if d := time.Since(start); d < 10*time.Millisecond {
+			t.Fatalf("timed out after %v", d)
+		}
+		// A late Ready must not ready the running goroutine, it leaves
+		// one permit.
+		p.Ready()
+		p.Ready()
+		if r := p.ParkTimeout(time.Millisecond); r != ParkWoken {
+			t.Fatalf("got %v after a late Ready, want %v", r, ParkWoken)
+		}
+		if r := p.ParkTimeout(time.Millisecond); r != ParkTimedOut {
+			t.Fatalf("got %v, want %v", r, ParkTimedOut)
+		}
+	})
+
+	t.Run("woken", func(t *testing.T) {
+		var p Park
+		res := make(chan ParkResult)
+		go func() { res <- p.ParkTimeout(time.Hour) }()
+		parked(&p)
+		p.Ready()
+		if r := <-res; r != ParkWoken {
+			t.Fatalf("got %v, want %v", r, ParkWoken)
+		}
+	})
+
+	t.Run("race", func(t *testing.T) {
+		var w sync.WaitGroup
+		for i := 0; i < 1000; i++ {
+			w.Add(1)
+			go func(i int) {
+				defer w.Done()
+				var p Park
+				res := make(chan ParkResult, 1)
+				go func() { res <- p.ParkTimeout(time.Duration(i%50+1) * time.Microsecond) }()
+				p.Ready()
+				if r := <-res; r != ParkWoken && r != ParkTimedOut {
+					t.Errorf("got %v", r)
+				}
+				p.Ready()
+			}(i)
+		}
+		w.Wait()
+	})
+}
+
+func TestParkContext(t *testing.T) {
+	t.Run("cancelled", func(t *testing.T) {
+		var p Park
+		ctx, cancel := context.WithCancel(context.Background())
+		res := make(chan ParkResult)
+		go func() { res <- p.ParkContext(ctx) }()
+		parked(&p)
+		cancel()
+		if r := <-res; r != ParkCancelled {
+			t.Fatalf("got %v, want %v", r, ParkCancelled)
+		}
+		if r := p.ParkContext(ctx); r != ParkCancelled {
+			t.Fatalf("got %v for a done context, want %v", r, ParkCancelled)
+		}
+		p.Ready()
+		if r := p.ParkContext(ctx); r != ParkWoken {
+			t.Fatalf("got %v with a permit, want %v", r, ParkWoken)
+		}
+	})
+
+	t.Run("stale cancel", func(t *testing.T) {
+		// The cancellation of a park woken meanwhile must not end the
+		// next one.
+		for i := 0; i < 200; i++ {
+			var p Park
+			ctx, cancel := context.WithCancel(context.Background())
+			res := make(chan ParkResult, 2)
+			go func() {
+				res <- p.ParkContext(ctx)
+				res <- p.ParkTimeout(time.Millisecond)
+			}()
+			parked(&p)
+			cancel()
+			p.Ready()
+			// Cancelled first, the Ready leaves a permit.
+			first, want := <-res, ParkTimedOut
+			if first == ParkCancelled {
+				want = ParkWoken
+			}
+			if r := <-res; r != want {
+				t.Fatalf("park after %v got %v, want %v", first, r, want)
+			}
+		}
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		var p Park
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+		if r := p.ParkContext(ctx); r != ParkCancelled || ctx.Err() != context.DeadlineExceeded {
+			t.Fatalf("got %v, %v", r, ctx.Err())
+		}
+	})
+
+	t.Run("woken", func(t *testing.T) {
+		var p Park
+		res := make(chan ParkResult)
+		go func() { res <- p.ParkContext(context.Background()) }()
+		parked(&p)
+		p.Ready()
+		if r := <-res; r != ParkWoken {
+			t.Fatalf("got %v, want %v", r, ParkWoken)
+		}
+	})
+}
+
+func TestParkOptions(t *testing.T) {
+	for _, opt := range []ParkOption{
+		WithWaitReason(g.WaitReason(255)),
+		WithTraceEvent(trace.TraceEvGCStart),
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("NewPark did not panic on an invalid option")
+				}
+			}()
+			NewPark(opt)
+		}()
+	}
+
+	var buf bytes.Buffer
+	if err := rtrace.Start(&buf); err != nil {
+		t.Fatal(err)
+	}
+	defer rtrace.Stop()
+
+	p := NewPark(WithWaitReason(g.WaitReasonSyncCondWait), WithTraceEvent(trace.TraceEvGoBlockCond))
+	done := make(chan struct{})
+	go func() {
+		p.Park(nil)
+		close(done)
+	}()
+	parked(&p)
+
+	gp := (*g.G)(p.Ptr())
+	if gp.WaitReason != g.WaitReasonSyncCondWait {
+		t.Errorf("parked with %q, want %q", gp.WaitReason, g.WaitReasonSyncCondWait)
+	}
+	stack := make([]byte, 1<<16)
+	stack = stack[:runtime.Stack(stack, true)]
+	if !bytes.Contains(stack, []byte("[sync.Cond.Wait]")) {
+		t.Errorf("no goroutine waits in sync.Cond.Wait:\n%s", stack)
+	}
+
+	p.Ready()
+	<-done
+}
@@ -0,0 +1,69 @@
+package gsysint
+
+import (
+	"unsafe"
+
+	"github.com/sitano/gsysint/g"
+)
+
+// HandoffTo readies the goroutine parked on p and yields the current one
+// to it, so it runs next on this P, as the runtime semaphores do for a
+// starving sync.Mutex. If the goroutine is not parked yet, HandoffTo
+// leaves the permit and returns.
+func HandoffTo(p *Park) {
+	if p.ready() {
+		yield()
+	}
+}
+
+// ReadyNext marks gp ready to run. With next it takes the runnext slot of
+// the current P and runs when the current goroutine blocks or yields,
+// as with GoReady; without it queues at the tail of the P run queue.
+// gp must be parked. Without next it panics if the M layout failed
+// g.Verify.
+func ReadyNext(gp *g.G, next bool) {
+	if next {
+		GoReady(gp, 0)
+		return
+	}
+	// The system stack runs a function with no closure, a closure would
+	// be instrumented under -race and the system stack has no race
+	// context. The argument goes through the waitlock of the M, which
+	// only gopark sets, with the goroutine pinned to the M meanwhile.
+	if g.CurM() == nil {
+		panic("gsysint: ReadyNext needs the M layout, it failed g.Verify")
+	}
+	procPin()
+	(*g.M)(g.GetM()).WaitLock = unsafe.Pointer(gp)
+	systemstack(readyTail)
+	procUnpin()
+}
+
+// readyTail readies the G in the waitlock of the M, at the tail of the
+// run queue.
+//
+//go:norace
+func readyTail() {
+	m := (*g.M)(g.GetM())
+	gp := (*g.G)(m.WaitLock)
+	m.WaitLock = nil
+	ready(gp, 0, false)
+}
+
+//go:linkname ready runtime.ready
+func ready(gp *g.G, traceskip int, next bool)
+
+// systemstack runs fn on the system stack, fn does not escape.
+//
+//go:linkname systemstack runtime.systemstack
+//go:noescape
+func systemstack(fn func())
+
+// procPin disables the preemption of the current goroutine, which stays
+// on its M until procUnpin. It returns the id of the P.
+//
+//go:linkname procPin runtime.procPin
+func procPin() int
+
+//go:linkname procUnpin runtime.procUnpin
+func procUnpin()
@@ -0,0 +1,120 @@
+package gsysint
+
+import (
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"unsafe"
+
+	"github.com/sitano/gsysint/g"
+	"github.com/sitano/gsysint/trace"
+)
+
+func TestHandoffTo(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(1))
+
+	var p Park
+	var ran int32
+	done := make(chan struct{})
+	go func() {
+		p.Park(nil)
+		atomic.StoreInt32(&ran, 1)
+		close(done)
+	}()
+	parked(&p)
+	HandoffTo(&p)
+	if atomic.LoadInt32(&ran) == 0 {
+		t.Fatal("the woken goroutine did not run first")
+	}
+	<-done
+
+	// Not parked yet: the permit is left.
+	HandoffTo(&p)
+	p.Park(nil)
+}
+
+// parkAlways is a gopark unlockf that keeps the goroutine parked. It runs
+// on the system stack, a closure would be instrumented under -race.
+//
+//go:norace
+func parkAlways(*g.G, unsafe.Pointer) bool { return true }
+
+func TestReadyNext(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(1))
+
+	for _, next := range []bool{false, true} {
+		ran := make(chan string, 2)
+		park := func(name string) *g.G {
+			var gp unsafe.Pointer
+			go func() {
+				atomic.StorePointer(&gp, g.GetG())
+				GoPark(parkAlways, nil, g.WaitReasonZero, trace.TraceEvNone, 1)
+				ran <- name
+			}()
+			rawParked(&gp)
+			return (*g.G)(gp)
+		}
+		queued, readied := park("queued"), park("readied")
+
+		// queued waits at the tail of the run queue, readied goes to
+		// runnext or behind it. Blocking on ran yields to them. The
+		// Param of the caller is left alone.
+		me, param := g.CurG(), unsafe.Pointer(new(int))
+		me.Param = param
+		ReadyNext(queued, false)
+		ReadyNext(readied, next)
+		if me.Param != param {
+			t.Errorf("next=%v: ReadyNext changed the Param of the caller", next)
+		}
+		me.Param = nil
+		first, second := <-ran, <-ran
+
+		want := "queued"
+		if next {
+			want = "readied"
+		}
+		// Under -race runqput ignores next half of the time.
+		if first != want && !(next && raceEnabled) {
+			t.Errorf("next=%v: %s ran before %s, want %s first", next, first, second, want)
+		}
+	}
+}
+
+func benchmarkPingPong(b *testing.B, wake func(*Park)) {
+	var ping, pong Park
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < b.N; i++ {
+			ping.Park(nil)
+			wake(&pong)
+		}
+		close(done)
+	}()
+	for i := 0; i < b.N; i++ {
+		wake(&ping)
+		pong.Park(nil)
+	}
+	<-done
+}
+
+func BenchmarkPingPongReady(b *testing.B) {
+	benchmarkPingPong(b, (*Park).Ready)
+}
+
+func BenchmarkPingPongHandoff(b *testing.B) {
+	benchmarkPingPong(b, HandoffTo)
+}
+
+func BenchmarkPingPongChan(b *testing.B) {
+	ping, pong := make(chan struct{}), make(chan struct{})
+	go func() {
+		for range ping {
+			pong <- struct{}{}
+		}
+	}()
+	for i := 0; i < b.N; i++ {
+		ping <- struct{}{}
+		<-pong
+	}
+	close(ping)
+}
@@ -0,0 +1,88 @@
+package layout
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/sitano/gsysint/g"
+)
+
+// MismatchError lists the differences between a mirror of package g and
+// the runtime type it mirrors.
+type MismatchError struct {
+	Type  string   // runtime type name
+	Diffs []string // one line per difference
+}
+
+func (e *MismatchError) Error() string {
+	return fmt.Sprintf("layout: g mirror of %s does not match the runtime: %s", e.Type, strings.Join(e.Diffs, "; "))
+}
+
+// mirrors are the package g types that have to match the runtime byte to
+// byte. A prefix mirror only covers the leading fields of the runtime type.
+var mirrors = []struct {
+	name   string
+	typ    reflect.Type
+	prefix bool
+}{
+	{"g", reflect.TypeOf(g.G{}), false},
+	{"m", reflect.TypeOf(g.M{}), false},
+	{"p", reflect.TypeOf(g.P{}), true},
+	{"schedt", reflect.TypeOf(g.SchedT{}), true},
+	{"hchan", reflect.TypeOf(g.HChan{}), false},
+	{"sudog", reflect.TypeOf(g.Sudog{}), false},
+	{"gobuf", reflect.TypeOf(g.GoBuf{}), false},
+}
+
+// Check cross-checks the static mirrors of package g against the DWARF
+// of the running executable. Mirror fields are matched to the runtime
+// ones by name, ignoring case and underscores (GoID ~ goid, Panic ~ _panic).
+func Check() error {
+	for _, m := range mirrors {
+		s, err := Runtime(m.name)
+		if err != nil {
+			return err
+		}
+		if err := compare(s, m.typ, m.prefix); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func compare(s *Struct, t reflect.Type, prefix bool) error {
+	fields := make(map[string]Field, len(s.Fields))
+	for _, f := range s.Fields {
+		fields[normalize(f.Name)] = f
+	}
+
+	var diffs []string
+	if !prefix && s.Size != t.Size() || prefix && s.Size < t.Size() {
+		diffs = append(diffs, fmt.Sprintf("size %d, want %d", t.Size(), s.Size))
+	}
+	for i := 0; i < t.NumField(); i++ {
+		mf := t.Field(i)
+		if mf.Name == "_" {
+			continue
+		}
+		f, ok := fields[normalize(mf.Name)]
+		switch {
+		case !ok:
+			diffs = append(diffs, fmt.Sprintf("%s is not in %s", mf.Name, s.Name))
+		case mf.Offset != f.Offset:
+			diffs = append(diffs, fmt.Sprintf("%s at %d, want %s at %d", mf.Name, mf.Offset, f.Name, f.Offset))
+		case mf.Type.Size() != f.Size:
+			diffs = append(diffs, fmt.Sprintf("%s of size %d, want %s of size %d", mf.Name, mf.Type.Size(), f.Name, f.Size))
+		}
+	}
+
+	if len(diffs) > 0 {
+		return &MismatchError{Type: s.Name, Diffs: diffs}
+	}
+	return nil
+}
+
+func normalize(name string) string {
+	return strings.ToLower(strings.Replace(name, "_", "", -1))
+}
@@ -0,0 +1,346 @@
+// Package layout resolves the layouts of the runtime structures (runtime.g,
+// runtime.m, runtime.p, runtime.hchan, ...) from the DWARF of the running
+// executable.
+//
+// The static mirrors of package g are the fast path. This package is the
+// slow but toolchain independent fallback and the cross-check for them:
+//
+//	id, err := layout.FieldUint64(g.CurG(), "goid")
+//
+// It needs the binary to be built with DWARF (no -ldflags=-w). go test
+// strips it by default, run the tests with -ldflags=-w=0 to cover them.
+package layout
+
+import (
+	"debug/dwarf"
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/sitano/gsysint/g"
+)
+
+var ErrNoDWARF = errors.New("layout: executable has no DWARF")
+
+// Field is a member of a struct, Offset is relative to the struct that
+// was asked for (nested members included).
+type Field struct {
+	Name   string
+	Offset uintptr
+	Size   uintptr
+	Type   string
+
+	typ dwarf.Type
+}
+
+// Struct is the layout of a struct type as recorded by the compiler.
+type Struct struct {
+	Name   string
+	Size   uintptr
+	Fields []Field
+
+	byName map[string]int
+}
+
+// Layout is the set of struct types found in an executable.
+type Layout struct {
+	data  *dwarf.Data
+	types map[string]dwarf.Offset
+
+	mu      sync.Mutex
+	structs map[string]*Struct
+}
+
+// Open reads the DWARF of the executable at path.
+func Open(path string) (*Layout, error) {
+	data, err := load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &Layout{
+		data:    data,
+		types:   make(map[string]dwarf.Offset),
+		structs: make(map[string]*Struct),
+	}
+
+	r := data.Reader()
+	for {
+		e, err := r.Next()
+		if err != nil {
+			return nil, err
+		}
+		if e == nil {
+			break
+		}
+		if e.Tag != dwarf.TagStructType {
+			continue
+		}
+		if name, ok := e.Val(dwarf.AttrName).(string); ok {
+			if _, dup := l.types[name]; !dup {
+				l.types[name] = e.Offset
+			}
+		}
+		r.SkipChildren()
+	}
+
+	return l, nil
+}
+
+func load(path string) (*dwarf.Data, error) {
+	if f, err := elf.Open(path); err == nil {
+		defer f.Close()
+		return dwarfOf(f.DWARF())
+	}
+	if f, err := macho.Open(path); err == nil {
+		defer f.Close()
+		return dwarfOf(f.DWARF())
+	}
+	if f, err := pe.Open(path); err == nil {
+		defer f.Close()
+		return dwarfOf(f.DWARF())
+	}
+	return nil, fmt.Errorf("layout: %s: unknown executable format", path)
+}
+
+func dwarfOf(d *dwarf.Data, err error) (*dwarf.Data, error) {
+	if err != nil {
+		return nil, ErrNoDWARF
+	}
+	return d, nil
+}
+
+var exe struct {
+	once sync.Once
+	l    *Layout
+	err  error
+}
+
+// Self returns the layout of the running executable (/proc/self/exe).
+// It is parsed once, on the first call.
+func Self() (*Layout, error) {
+	exe.once.Do(func() {
+		exe.l, exe.err = Open(selfPath())
+	})
+	return exe.l, exe.err
+}
+
+// selfPath returns the path to the running executable. /proc/self/exe
+// opens the running inode: os.Executable resolves it to a path, which
+// names another binary once a deploy renames a new one over it.
+func selfPath() string {
+	switch runtime.GOOS {
+	case "linux", "android":
+		return "/proc/self/exe"
+	}
+	if path, err := os.Executable(); err == nil {
+		return path
+	}
+	return "/proc/self/exe"
+}
+
+// Struct returns the layout of the struct type with the given name,
+// e.g. "runtime.g".
+func (l *Layout) Struct(name string) (*Struct, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if s, ok := l.structs[name]; ok {
+		return s, nil
+	}
+
+	off, ok := l.types[name]
+	if !ok {
+		return nil, fmt.Errorf("layout: no type %s", name)
+	}
+	t, err := l.data.Type(off)
+	if err != nil {
+		return nil, err
+	}
+	st, ok := t.(*dwarf.StructType)
+	if !ok {
+		return nil, fmt.Errorf("layout: %s is %T, not a struct", name, t)
+	}
+
+	s := newStruct(st)
+	l.structs[name] = s
+	return s, nil
+}
+
+func newStruct(st *dwarf.StructType) *Struct {
+	s := &Struct{
+		Name:   st.StructName,
+		Size:   uintptr(st.ByteSize),
+		Fields: make([]Field, 0, len(st.Field)),
+		byName: make(map[string]int, len(st.Field)),
+	}
+	for _, f := range st.Field {
+		s.byName[f.Name] = len(s.Fields)
+		s.Fields = append(s.Fields, Field{
+			Name:   f.Name,
+			Offset: uintptr(f.ByteOffset),
+			Size:   uintptr(f.Type.Size()),
+			Type:   f.Type.String(),
+			typ:    f.Type,
+		})
+	}
+	return s
+}
+
+// Field returns the member at path. Members of nested structs are
+// separated by dots, e.g. "sched.sp" or "stack.lo".
+func (s *Struct) Field(path string) (Field, error) {
+	cur := s
+	var base uintptr
+	for {
+		name := path
+		rest := ""
+		if i := strings.IndexByte(path, '.'); i >= 0 {
+			name, rest = path[:i], path[i+1:]
+		}
+
+		i, ok := cur.byName[name]
+		if !ok {
+			return Field{}, fmt.Errorf("layout: %s has no field %s", cur.Name, name)
+		}
+		f := cur.Fields[i]
+		f.Offset += base
+		if rest == "" {
+			return f, nil
+		}
+
+		st, ok := underlying(f.typ).(*dwarf.StructType)
+		if !ok {
+			return Field{}, fmt.Errorf("layout: %s.%s is %s, not a struct", cur.Name, name, f.Type)
+		}
+		cur, base, path = newStruct(st), f.Offset, rest
+	}
+}
+
+func underlying(t dwarf.Type) dwarf.Type {
+	for {
+		td, ok := t.(*dwarf.TypedefType)
+		if !ok {
+			return t
+		}
+		t = td.Type
+	}
+}
+
+// Offset returns the offset of the member at path.
+func (s *Struct) Offset(path string) (uintptr, error) {
+	f, err := s.Field(path)
+	return f.Offset, err
+}
+
+// Uint64 reads an integer member of size 1, 2, 4 or 8 of the struct at p,
+// zero extended.
+func (s *Struct) Uint64(p unsafe.Pointer, path string) (uint64, error) {
+	f, err := s.Field(path)
+	if err != nil {
+		return 0, err
+	}
+	if p == nil {
+		return 0, fmt.Errorf("layout: nil %s", s.Name)
+	}
+	a := unsafe.Pointer(uintptr(p) + f.Offset)
+	switch f.Size {
+	case 1:
+		return uint64(*(*uint8)(a)), nil
+	case 2:
+		return uint64(*(*uint16)(a)), nil
+	case 4:
+		return uint64(*(*uint32)(a)), nil
+	case 8:
+		return *(*uint64)(a), nil
+	}
+	return 0, fmt.Errorf("layout: %s.%s is %s of size %d, not an integer", s.Name, path, f.Type, f.Size)
+}
+
+// Uintptr reads a pointer sized member of the struct at p.
+func (s *Struct) Uintptr(p unsafe.Pointer, path string) (uintptr, error) {
+	f, err := s.Field(path)
+	if err != nil {
+		return 0, err
+	}
+	if f.Size != unsafe.Sizeof(uintptr(0)) {
+		return 0, fmt.Errorf("layout: %s.%s is %s of size %d, not pointer sized", s.Name, path, f.Type, f.Size)
+	}
+	if p == nil {
+		return 0, fmt.Errorf("layout: nil %s", s.Name)
+	}
+	return *(*uintptr)(unsafe.Pointer(uintptr(p) + f.Offset)), nil
+}
+
+// Pointer reads a pointer member of the struct at p.
+func (s *Struct) Pointer(p unsafe.Pointer, path string) (unsafe.Pointer, error) {
+	f, err := s.Field(path)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := underlying(f.typ).(*dwarf.PtrType); !ok {
+		return nil, fmt.Errorf("layout: %s.%s is %s, not a pointer", s.Name, path, f.Type)
+	}
+	if p == nil {
+		return nil, fmt.Errorf("layout: nil %s", s.Name)
+	}
+	return *(*unsafe.Pointer)(unsafe.Pointer(uintptr(p) + f.Offset)), nil
+}
+
+// Runtime returns the layout of runtime.<name> of the running executable.
+func Runtime(name string) (*Struct, error) {
+	l, err := Self()
+	if err != nil {
+		return nil, err
+	}
+	return l.Struct("runtime." + name)
+}
+
+// G returns the layout of runtime.g.
+func G() (*Struct, error) { return Runtime("g") }
+
+// M returns the layout of runtime.m.
+func M() (*Struct, error) { return Runtime("m") }
+
+// P returns the layout of runtime.p.
+func P() (*Struct, error) { return Runtime("p") }
+
+// HChan returns the layout of runtime.hchan.
+func HChan() (*Struct, error) { return Runtime("hchan") }
+
+// FieldUint64 reads the integer field name of gp through the DWARF layout
+// of runtime.g.
+func FieldUint64(gp *g.G, name string) (uint64, error) {
+	s, err := G()
+	if err != nil {
+		return 0, err
+	}
+	return s.Uint64(unsafe.Pointer(gp), name)
+}
+
+// FieldUintptr reads the pointer sized field name of gp through the DWARF
+// layout of runtime.g.
+func FieldUintptr(gp *g.G, name string) (uintptr, error) {
+	s, err := G()
+	if err != nil {
+		return 0, err
+	}
+	return s.Uintptr(unsafe.Pointer(gp), name)
+}
+
+// FieldPointer reads the pointer field name of gp through the DWARF layout
+// of runtime.g.
+func FieldPointer(gp *g.G, name string) (unsafe.Pointer, error) {
+	s, err := G()
+	if err != nil {
+		return nil, err
+	}
+	return s.Pointer(unsafe.Pointer(gp), name)
+}
@@ -0,0 +1,85 @@
+package layout
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/sitano/gsysint/g"
+)
+
+func self(t *testing.T) *Layout {
+	l, err := Self()
+	if err == ErrNoDWARF {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l
+}
+
+func TestFieldUint64(t *testing.T) {
+	self(t)
+
+	gp := g.CurG()
+	id, err := FieldUint64(gp, "goid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != uint64(gp.GoID) {
+		t.Errorf("goid = %d, want %d", id, gp.GoID)
+	}
+
+	m, err := FieldPointer(gp, "m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m != unsafe.Pointer(gp.M) {
+		t.Errorf("m = %p, want %p", m, gp.M)
+	}
+
+	if _, err := FieldUint64(gp, "nosuchfield"); err == nil {
+		t.Error("no error for unknown field")
+	}
+}
+
+func TestNested(t *testing.T) {
+	l := self(t)
+
+	s, err := l.Struct("runtime.g")
+	if err != nil {
+		t.Fatal(err)
+	}
+	off, err := s.Offset("sched.sp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := unsafe.Offsetof(g.G{}.Sched); off < want || off >= want+unsafe.Sizeof(g.GoBuf{}) {
+		t.Errorf("sched.sp at %d, outside of sched at %d", off, want)
+	}
+	if _, err := s.Offset("goid.x"); err == nil {
+		t.Error("no error for a path through a non struct")
+	}
+}
+
+func TestRuntimeTypes(t *testing.T) {
+	self(t)
+
+	for _, f := range []func() (*Struct, error){G, M, P, HChan} {
+		s, err := f()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.Size == 0 || len(s.Fields) == 0 {
+			t.Errorf("%s: empty layout", s.Name)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	self(t)
+
+	if err := Check(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,7 +1,7 @@
 package gsysint
 
 import (
-	_ "unsafe"
+	"unsafe"
 
 	"github.com/sitano/gsysint/g"
 )
@@ -19,3 +19,15 @@ func Lock(l *Mutex) {
 func Unlock(l *Mutex) {
 	g.Unlock(l)
 }
+
+// lock and unlock are Lock and Unlock telling the race detector, which
+// does not see the runtime lock, about the synchronization through l.
+func lock(l *Mutex) {
+	Lock(l)
+	raceAcquire(unsafe.Pointer(l))
+}
+
+func unlock(l *Mutex) {
+	raceRelease(unsafe.Pointer(l))
+	Unlock(l)
+}
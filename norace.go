@@ -0,0 +1,10 @@
+//go:build !race
+// +build !race
+
+package gsysint
+
+import "unsafe"
+
+func raceAcquire(addr unsafe.Pointer) {}
+
+func raceRelease(addr unsafe.Pointer) {}
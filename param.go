@@ -0,0 +1,54 @@
+package gsysint
+
+import (
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/sitano/gsysint/g"
+)
+
+// ReadyWith readies the goroutine parked in p.ParkRecv and hands it v, or
+// leaves the permit with v for its next park, as Ready does: the next
+// ParkRecv returns v at once. It reports whether v was handed over:
+// false if the goroutine has been woken already, or the permit is there,
+// or it is in another park, which takes no value and is left parked.
+//
+// A permit left by ReadyWith is consumed by the next park of any kind,
+// only ParkRecv receives its value.
+func ReadyWith(p *Park, v unsafe.Pointer) bool {
+	// The value is stored before the wake that carries it is published,
+	// the wakers with a value must not overwrite each other's.
+	lock(&p.vlock)
+	for {
+		s := atomic.LoadUint32(&p.state)
+		phase := s & parkPhase
+		switch {
+		case phase == parkEmpty:
+		case (phase == parkArmed || phase == parkWaiting) && s&parkRecv != 0:
+		default:
+			// Woken already, or in a park that takes no value.
+			unlock(&p.vlock)
+			return false
+		}
+		atomic.StorePointer(&p.val, v)
+		if atomic.CompareAndSwapUint32(&p.state, s, s&^parkPhase|uint32(ParkWoken)|parkValue) {
+			unlock(&p.vlock)
+			if phase == parkWaiting {
+				GoReady((*g.G)(p.Ptr()), 1)
+			}
+			return true
+		}
+	}
+}
+
+// ParkRecv parks the current goroutine like Park and returns the value
+// of ReadyWith, nil if it was woken by Ready.
+func (p *Park) ParkRecv() unsafe.Pointer {
+	p.recv = nil
+	if _, ok := p.arm(parkRecv); ok {
+		p.wait(nil)
+	}
+	v := p.recv
+	p.recv = nil
+	return v
+}
@@ -0,0 +1,29 @@
+//go:build go1.18
+// +build go1.18
+
+package gsysint
+
+import "unsafe"
+
+// ParkOf is a Park handing *T values to the woken goroutine.
+type ParkOf[T any] struct {
+	p Park
+}
+
+// ParkRecv parks the current goroutine until ReadyWith, and returns its
+// value. See Park.ParkRecv.
+func (p *ParkOf[T]) ParkRecv() *T {
+	return (*T)(p.p.ParkRecv())
+}
+
+// ReadyWith readies the goroutine parked in ParkRecv with v, or leaves the
+// permit with v. See ReadyWith.
+func (p *ParkOf[T]) ReadyWith(v *T) bool {
+	return ReadyWith(&p.p, unsafe.Pointer(v))
+}
+
+// Ready readies the goroutine parked in ParkRecv with nil, or leaves the
+// permit.
+func (p *ParkOf[T]) Ready() {
+	p.p.Ready()
+}
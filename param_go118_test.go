@@ -0,0 +1,50 @@
+//go:build go1.18
+// +build go1.18
+
+package gsysint
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+func TestParkOf(t *testing.T) {
+	type msg struct{ n int }
+	var req, resp ParkOf[msg]
+	var stop int32
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for atomic.LoadInt32(&stop) == 0 {
+			m := req.ParkRecv()
+			if m == nil {
+				continue
+			}
+			m.n++
+			if !resp.ReadyWith(m) {
+				t.Error("the response was not delivered")
+				return
+			}
+		}
+	}()
+
+	m := &msg{}
+	allocs := testing.AllocsPerRun(1000, func() {
+		if !req.ReadyWith(m) {
+			t.Fatal("the request was not delivered")
+		}
+		if resp.ParkRecv() != m {
+			t.Fatal("got another message back")
+		}
+	})
+	atomic.StoreInt32(&stop, 1)
+	req.Ready()
+	<-done
+
+	if m.n != 1001 {
+		t.Fatalf("message passed %d times, want 1001", m.n)
+	}
+	if allocs != 0 {
+		t.Fatalf("%v allocations per round trip", allocs)
+	}
+}
@@ -0,0 +1,74 @@
+package gsysint
+
+import (
+	"testing"
+	"time"
+	"unsafe"
+)
+
+func TestParkRecv(t *testing.T) {
+	var p Park
+	vals := make([]int, 1000)
+
+	// ReadyWith before the park leaves the permit with the value.
+	if !ReadyWith(&p, unsafe.Pointer(&vals[0])) {
+		t.Fatal("ReadyWith left no permit")
+	}
+	if ReadyWith(&p, unsafe.Pointer(&vals[1])) {
+		t.Fatal("ReadyWith delivered over a permit")
+	}
+	if v := p.ParkRecv(); v != unsafe.Pointer(&vals[0]) {
+		t.Fatalf("ParkRecv on the permit got %p, want %p", v, &vals[0])
+	}
+
+	// Half of the values go to the parked goroutine, the others to it
+	// parking or about to.
+	got := make(chan unsafe.Pointer)
+	go func() {
+		for range vals {
+			got <- p.ParkRecv()
+		}
+	}()
+	for i := range vals {
+		if i%2 == 0 {
+			parked(&p)
+		}
+		if !ReadyWith(&p, unsafe.Pointer(&vals[i])) {
+			t.Fatalf("ReadyWith %d did not deliver", i)
+		}
+		if v := <-got; v != unsafe.Pointer(&vals[i]) {
+			t.Fatalf("ParkRecv %d got %p, want %p", i, v, &vals[i])
+		}
+	}
+
+	// Ready leaves a permit that ParkRecv takes without a value.
+	p.Ready()
+	if ReadyWith(&p, unsafe.Pointer(&vals[0])) {
+		t.Fatal("ReadyWith delivered over a permit")
+	}
+	if v := p.ParkRecv(); v != nil {
+		t.Fatalf("ParkRecv on the permit got %p", v)
+	}
+
+	// The other parks take no value, ReadyWith leaves them parked.
+	res := make(chan ParkResult)
+	go func() { res <- p.ParkTimeout(time.Hour) }()
+	parked(&p)
+	if ReadyWith(&p, unsafe.Pointer(&vals[0])) {
+		t.Fatal("ReadyWith delivered to ParkTimeout")
+	}
+	p.Ready()
+	if r := <-res; r != ParkWoken {
+		t.Fatalf("ParkTimeout got %v, want %v", r, ParkWoken)
+	}
+
+	// They consume the permit of ReadyWith, its value is dropped.
+	ReadyWith(&p, unsafe.Pointer(&vals[0]))
+	if r := p.ParkTimeout(time.Hour); r != ParkWoken {
+		t.Fatalf("ParkTimeout on the permit got %v, want %v", r, ParkWoken)
+	}
+	p.Ready()
+	if v := p.ParkRecv(); v != nil {
+		t.Fatalf("ParkRecv after a dropped value got %p", v)
+	}
+}
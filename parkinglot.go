@@ -0,0 +1,153 @@
+package gsysint
+
+import (
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/sitano/gsysint/g"
+	"github.com/sitano/gsysint/trace"
+)
+
+// The parking lot parks goroutines on any address, like a futex: a hash
+// table of wait queues keyed by the address, as in WebKit and Rust
+// parking_lot. The waiters are chained through their G.SchedLink and
+// keep the address in G.Param while they are parked.
+
+const lotSize = 251 // prime, as the runtime semaphore table
+
+type lotBucket struct {
+	lock       g.Mutex
+	head, tail g.Guintptr
+	wakes      uintptr // bumped under lock by the unparks, see ParkOn
+}
+
+var lot [lotSize]struct {
+	lotBucket
+	_ [64 - unsafe.Sizeof(lotBucket{})%64]byte
+}
+
+func lotFor(addr unsafe.Pointer) *lotBucket {
+	return &lot[(uintptr(addr)>>3)%lotSize].lotBucket
+}
+
+// ParkOn parks the current goroutine on addr if validate returns true.
+// Otherwise it returns false at once. validate runs with the preemption
+// disabled, out of the lock of the addr bucket: it must not block, that
+// is send, receive, lock or park. A wake can not slip in between it and
+// the park: if the bucket had an unpark meanwhile, validate runs again.
+// It panics if the G layout failed g.Verify.
+func ParkOn(addr unsafe.Pointer, validate func() bool) bool {
+	gp := g.CurG()
+	if gp == nil {
+		panic("gsysint: ParkOn needs the G layout, it failed g.Verify")
+	}
+	b := lotFor(addr)
+	for {
+		wakes := atomic.LoadUintptr(&b.wakes)
+		procPin()
+		ok := validate()
+		procUnpin()
+		if !ok {
+			return false
+		}
+		lock(&b.lock)
+		if b.wakes == wakes {
+			break
+		}
+		unlock(&b.lock)
+	}
+	gp.Param = addr
+	gp.SchedLink = 0
+	if tail := b.tail.Ptr(); tail != nil {
+		tail.SchedLink.Set(gp)
+	} else {
+		b.head.Set(gp)
+	}
+	b.tail.Set(gp)
+	raceRelease(unsafe.Pointer(&b.lock))
+	GoParkUnlock(&b.lock, g.WaitReasonZero, trace.TraceEvNone, 1)
+	// The waker released gp before it readied it.
+	raceAcquire(unsafe.Pointer(gp))
+	return true
+}
+
+// UnparkOne readies the first goroutine parked on addr. It reports
+// whether there was one and whether more goroutines may be parked on
+// addr, for the lock word to keep its waiters bit.
+func UnparkOne(addr unsafe.Pointer) (woken, more bool) {
+	b := lotFor(addr)
+	lock(&b.lock)
+	atomic.AddUintptr(&b.wakes, 1)
+	var prev, gp *g.G
+	for gp = b.head.Ptr(); gp != nil; prev, gp = gp, gp.SchedLink.Ptr() {
+		if gp.Param == addr {
+			break
+		}
+	}
+	if gp == nil {
+		unlock(&b.lock)
+		return false, false
+	}
+	b.remove(prev, gp)
+	for next := gp.SchedLink.Ptr(); next != nil; next = next.SchedLink.Ptr() {
+		if next.Param == addr {
+			more = true
+			break
+		}
+	}
+	unlock(&b.lock)
+
+	gp.Param, gp.SchedLink = nil, 0
+	raceRelease(unsafe.Pointer(gp))
+	GoReady(gp, 1)
+	return true, more
+}
+
+// UnparkAll readies all the goroutines parked on addr in order and
+// returns how many.
+func UnparkAll(addr unsafe.Pointer) int {
+	b := lotFor(addr)
+	lock(&b.lock)
+	atomic.AddUintptr(&b.wakes, 1)
+	var head, tail, prev *g.G
+	n := 0
+	for gp := b.head.Ptr(); gp != nil; {
+		next := gp.SchedLink.Ptr()
+		if gp.Param != addr {
+			prev, gp = gp, next
+			continue
+		}
+		b.remove(prev, gp)
+		gp.SchedLink = 0
+		if tail != nil {
+			tail.SchedLink.Set(gp)
+		} else {
+			head = gp
+		}
+		tail = gp
+		n++
+		gp = next
+	}
+	unlock(&b.lock)
+
+	for gp := head; gp != nil; {
+		next := gp.SchedLink.Ptr()
+		gp.Param, gp.SchedLink = nil, 0
+		raceRelease(unsafe.Pointer(gp))
+		GoReady(gp, 1)
+		gp = next
+	}
+	return n
+}
+
+// remove unlinks gp that follows prev, gp keeps its SchedLink.
+func (b *lotBucket) remove(prev, gp *g.G) {
+	if prev == nil {
+		b.head = gp.SchedLink
+	} else {
+		prev.SchedLink = gp.SchedLink
+	}
+	if b.tail.Ptr() == gp {
+		b.tail.Set(prev)
+	}
+}
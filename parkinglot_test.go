@@ -0,0 +1,112 @@
+package gsysint
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"unsafe"
+)
+
+// wordMutex is a futex mutex on the parking lot: 0 unlocked, 1 locked,
+// 2 locked with waiters.
+type wordMutex uint32
+
+func (m *wordMutex) lock() {
+	w := (*uint32)(m)
+	if atomic.CompareAndSwapUint32(w, 0, 1) {
+		return
+	}
+	for atomic.SwapUint32(w, 2) != 0 {
+		ParkOn(unsafe.Pointer(w), func() bool { return atomic.LoadUint32(w) == 2 })
+	}
+}
+
+func (m *wordMutex) unlock() {
+	w := (*uint32)(m)
+	if atomic.SwapUint32(w, 0) == 2 {
+		UnparkOne(unsafe.Pointer(w))
+	}
+}
+
+func TestParkingLotMutex(t *testing.T) {
+	var m wordMutex
+	var n int
+	var w sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		w.Add(1)
+		go func() {
+			defer w.Done()
+			for j := 0; j < 10000; j++ {
+				m.lock()
+				n++
+				m.unlock()
+			}
+		}()
+	}
+	w.Wait()
+	if n != 80000 {
+		t.Fatalf("counted %d, want 80000", n)
+	}
+}
+
+func TestParkingLot(t *testing.T) {
+	// Two words in the same bucket.
+	words := make([]uint64, lotSize+1)
+	a, b := unsafe.Pointer(&words[0]), unsafe.Pointer(&words[lotSize])
+	if lotFor(a) != lotFor(b) {
+		t.Fatal("the words are in different buckets")
+	}
+
+	if ParkOn(a, func() bool { return false }) {
+		t.Fatal("parked with validate false")
+	}
+	if woken, _ := UnparkOne(a); woken {
+		t.Fatal("woke a goroutine of an empty address")
+	}
+
+	var w sync.WaitGroup
+	park := func(addr unsafe.Pointer) {
+		w.Add(1)
+		go func() {
+			defer w.Done()
+			ParkOn(addr, func() bool { return true })
+		}()
+	}
+	// A goroutine in the bucket is parked once the bucket lock is free.
+	wait := func(n int) {
+		for {
+			b := lotFor(a)
+			lock(&b.lock)
+			queued := 0
+			for gp := b.head.Ptr(); gp != nil; gp = gp.SchedLink.Ptr() {
+				queued++
+			}
+			unlock(&b.lock)
+			if queued == n {
+				return
+			}
+			runtime.Gosched()
+		}
+	}
+
+	park(a)
+	park(b)
+	park(a)
+	park(a)
+	wait(4)
+
+	if woken, more := UnparkOne(a); !woken || !more {
+		t.Fatalf("UnparkOne: woken %v, more %v", woken, more)
+	}
+	if n := UnparkAll(a); n != 2 {
+		t.Fatalf("UnparkAll woke %d, want 2", n)
+	}
+	if woken, more := UnparkOne(b); !woken || more {
+		t.Fatalf("UnparkOne: woken %v, more %v", woken, more)
+	}
+	w.Wait()
+	if lot := lotFor(a); lot.head != 0 || lot.tail != 0 {
+		t.Fatal("goroutines left in the bucket")
+	}
+}
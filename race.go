@@ -0,0 +1,21 @@
+//go:build race
+// +build race
+
+package gsysint
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// The race detector does not see the runtime locks and the parks, the
+// code under a g.Mutex and the readies of parked goroutines tell it
+// about them with raceAcquire and raceRelease.
+
+func raceAcquire(addr unsafe.Pointer) {
+	runtime.RaceAcquire(addr)
+}
+
+func raceRelease(addr unsafe.Pointer) {
+	runtime.RaceRelease(addr)
+}
@@ -0,0 +1,44 @@
+package gsysint
+
+import (
+	"sync/atomic"
+)
+
+// Sema is a counting semaphore on the runtime semaphores, the ones of
+// sync.Mutex and sync.WaitGroup. Its value is the count, a zero Sema has
+// nothing to acquire. Waiters show as [semacquire] in the dumps.
+type Sema uint32
+
+const semaBlockProfile = 1 // runtime.semaBlockProfile
+
+// Acquire waits until s is positive and decrements it. The waiters are
+// woken in FIFO order.
+func (s *Sema) Acquire() {
+	semacquire((*uint32)(s), false)
+}
+
+// AcquireLIFO is Acquire queueing at the head of the waiters, as a
+// sync.Mutex waiter that has been woken already does.
+func (s *Sema) AcquireLIFO() {
+	semacquire((*uint32)(s), true)
+}
+
+// TryAcquire decrements s if it is positive and reports whether it did.
+func (s *Sema) TryAcquire() bool {
+	for {
+		v := atomic.LoadUint32((*uint32)(s))
+		if v == 0 {
+			return false
+		}
+		if atomic.CompareAndSwapUint32((*uint32)(s), v, v-1) {
+			return true
+		}
+	}
+}
+
+// Release increments s and wakes a waiter, if any. With handoff the count
+// is passed to the first waiter directly and the current goroutine yields
+// to it, so no other goroutine can barge in.
+func (s *Sema) Release(handoff bool) {
+	semrelease((*uint32)(s), handoff)
+}
@@ -0,0 +1,24 @@
+//go:build go1.20
+// +build go1.20
+
+package gsysint
+
+import (
+	_ "unsafe"
+
+	"github.com/sitano/gsysint/g"
+)
+
+//go:linkname semacquire1 runtime.semacquire1
+func semacquire1(addr *uint32, lifo bool, profile int, skipframes int, reason g.WaitReason)
+
+//go:linkname semrelease1 runtime.semrelease1
+func semrelease1(addr *uint32, handoff bool, skipframes int)
+
+func semacquire(addr *uint32, lifo bool) {
+	semacquire1(addr, lifo, semaBlockProfile, 0, g.WaitReasonSemacquire)
+}
+
+func semrelease(addr *uint32, handoff bool) {
+	semrelease1(addr, handoff, 0)
+}
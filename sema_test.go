@@ -0,0 +1,118 @@
+package gsysint
+
+import (
+	"bytes"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestSema(t *testing.T) {
+	var s Sema
+	if s.TryAcquire() {
+		t.Fatal("TryAcquire acquired a zero semaphore")
+	}
+	s.Release(false)
+	if !s.TryAcquire() || s.TryAcquire() {
+		t.Fatal("TryAcquire does not take the one count")
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.Acquire()
+		close(done)
+	}()
+	for {
+		stack := make([]byte, 1<<16)
+		stack = stack[:runtime.Stack(stack, true)]
+		if bytes.Contains(stack, []byte("[semacquire]")) {
+			break
+		}
+		runtime.Gosched()
+	}
+	s.Release(true)
+	<-done
+}
+
+func TestSemaLimit(t *testing.T) {
+	const limit = 3
+	s := Sema(limit)
+	var in, max int32
+	var w sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		w.Add(1)
+		go func(i int) {
+			defer w.Done()
+			for j := 0; j < 1000; j++ {
+				if i%2 == 0 {
+					s.Acquire()
+				} else {
+					s.AcquireLIFO()
+				}
+				n := atomic.AddInt32(&in, 1)
+				for {
+					m := atomic.LoadInt32(&max)
+					if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+						break
+					}
+				}
+				atomic.AddInt32(&in, -1)
+				s.Release(j%3 == 0)
+			}
+		}(i)
+	}
+	w.Wait()
+	if max > limit {
+		t.Fatalf("%d goroutines in, limit %d", max, limit)
+	}
+	if s != limit {
+		t.Fatalf("semaphore at %d after all released, want %d", s, limit)
+	}
+}
+
+func BenchmarkSemaUncontended(b *testing.B) {
+	s := Sema(1)
+	for i := 0; i < b.N; i++ {
+		s.Acquire()
+		s.Release(false)
+	}
+}
+
+func BenchmarkSyncMutexUncontended(b *testing.B) {
+	var m sync.Mutex
+	for i := 0; i < b.N; i++ {
+		m.Lock()
+		m.Unlock()
+	}
+}
+
+func BenchmarkSemaContended(b *testing.B) {
+	s := Sema(1)
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			s.Acquire()
+			s.Release(false)
+		}
+	})
+}
+
+func BenchmarkSemaContendedHandoff(b *testing.B) {
+	s := Sema(1)
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			s.Acquire()
+			s.Release(true)
+		}
+	})
+}
+
+func BenchmarkSyncMutexContended(b *testing.B) {
+	var m sync.Mutex
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			m.Lock()
+			m.Unlock()
+		}
+	})
+}
@@ -0,0 +1,18 @@
+package gsysint
+
+import (
+	"time"
+	_ "unsafe"
+)
+
+//go:linkname nanotime runtime.nanotime
+func nanotime() int64
+
+// when is the runtime timer time d from now, on overflow the furthest one.
+func when(d time.Duration) int64 {
+	t := nanotime() + int64(d)
+	if t < 0 {
+		t = 1<<63 - 1
+	}
+	return t
+}
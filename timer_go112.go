@@ -0,0 +1,44 @@
+//go:build !go1.23
+// +build !go1.23
+
+package gsysint
+
+import (
+	"time"
+	_ "unsafe"
+
+	"github.com/sitano/gsysint/g"
+)
+
+// startTimer adds t to the timer heap, t has the layout of time.runtimeTimer.
+//
+//go:linkname startTimer time.startTimer
+func startTimer(t *g.Timer)
+
+//go:linkname stopTimer time.stopTimer
+func stopTimer(t *g.Timer) bool
+
+type parkTimer struct {
+	t *g.Timer
+}
+
+// startParkTimer starts the timer that ends the park of generation gen
+// with r. Both are passed to the callback in the timer seq: a generation
+// leaves the bits of the phase clear.
+func startParkTimer(p *Park, gen uint32, d time.Duration, r ParkResult) parkTimer {
+	t := &g.Timer{When: when(d), F: parkTimeout, Arg: p, Seq: uintptr(gen | uint32(r))}
+	startTimer(t)
+	return parkTimer{t}
+}
+
+func (t parkTimer) stop() { stopTimer(t.t) }
+
+// parkTimeout is the timer callback of ParkTimeout, and of the deadline
+// of ParkContext. The runtime runs it on the system stack, since go1.14
+// from the scheduler.
+//
+//go:norace
+func parkTimeout(arg interface{}, seq uintptr) {
+	s := uint32(seq)
+	arg.(*Park).wake(s&^(parkGen-1), ParkResult(s&parkPhase))
+}
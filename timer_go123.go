@@ -0,0 +1,46 @@
+//go:build go1.23
+// +build go1.23
+
+package gsysint
+
+import (
+	"time"
+	"unsafe"
+)
+
+// newTimer allocates and starts a runtime timer without a channel, the
+// result is a *runtime.timeTimer.
+//
+//go:linkname newTimer time.newTimer
+func newTimer(when, period int64, f func(interface{}, uintptr, int64), arg interface{}, c unsafe.Pointer) unsafe.Pointer
+
+//go:linkname stopTimer time.stopTimer
+func stopTimer(t unsafe.Pointer) bool
+
+type parkTimer struct {
+	t unsafe.Pointer
+}
+
+// parkTimerArg is the park of a timer, newTimer leaves the timer seq 0.
+type parkTimerArg struct {
+	p   *Park
+	gen uint32
+	r   ParkResult
+}
+
+// startParkTimer starts the timer that ends the park of generation gen
+// with r.
+func startParkTimer(p *Park, gen uint32, d time.Duration, r ParkResult) parkTimer {
+	return parkTimer{newTimer(when(d), 0, parkTimeout, parkTimerArg{p, gen, r}, nil)}
+}
+
+func (t parkTimer) stop() { stopTimer(t.t) }
+
+// parkTimeout is the timer callback of ParkTimeout. The runtime runs it on
+// the system stack, since go1.14 from the scheduler.
+//
+//go:norace
+func parkTimeout(arg interface{}, _ uintptr, _ int64) {
+	a := arg.(parkTimerArg)
+	a.p.wake(a.gen, a.r)
+}
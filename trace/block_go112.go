@@ -0,0 +1,10 @@
+//go:build !go1.22
+// +build !go1.22
+
+package trace
+
+// BlockReason returns the gopark trace argument for the block event ev.
+// Up to go1.21 it is the event itself.
+func BlockReason(ev byte) byte {
+	return ev
+}
@@ -0,0 +1,45 @@
+//go:build go1.22
+// +build go1.22
+
+package trace
+
+// The runtime.traceBlockReason values gopark takes since go1.22.
+const (
+	traceBlockGeneric = iota
+	traceBlockForever
+	traceBlockNet
+	traceBlockSelect
+	traceBlockCondWait
+	traceBlockSync
+	traceBlockChanSend
+	traceBlockChanRecv
+	traceBlockGCMarkAssist
+	traceBlockGCSweep
+	traceBlockSystemGoroutine
+	traceBlockPreempted
+	traceBlockDebugCall
+	traceBlockUntilGCEnds
+	traceBlockSleep
+)
+
+var blockReasons = [...]byte{
+	TraceEvGoStop:        traceBlockForever,
+	TraceEvGoSleep:       traceBlockSleep,
+	TraceEvGoBlockSend:   traceBlockChanSend,
+	TraceEvGoBlockRecv:   traceBlockChanRecv,
+	TraceEvGoBlockSelect: traceBlockSelect,
+	TraceEvGoBlockSync:   traceBlockSync,
+	TraceEvGoBlockCond:   traceBlockCondWait,
+	TraceEvGoBlockNet:    traceBlockNet,
+	TraceEvGoBlockGC:     traceBlockGCMarkAssist,
+}
+
+// BlockReason returns the gopark trace argument for the block event ev.
+// Since go1.22 it is a traceBlockReason, TraceEvNone and TraceEvGoBlock
+// are the generic one.
+func BlockReason(ev byte) byte {
+	if int(ev) < len(blockReasons) {
+		return blockReasons[ev]
+	}
+	return traceBlockGeneric
+}
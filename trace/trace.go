@@ -102,3 +102,16 @@ const (
 	// but are generally not interesting for end user.
 	TraceFutileWakeup byte = 128
 )
+
+// IsBlockEvent reports whether ev is an event of a goroutine blocking in
+// gopark, or TraceEvNone.
+func IsBlockEvent(ev byte) bool {
+	switch ev {
+	case TraceEvNone, TraceEvGoStop, TraceEvGoSleep, TraceEvGoBlock,
+		TraceEvGoBlockSend, TraceEvGoBlockRecv, TraceEvGoBlockSelect,
+		TraceEvGoBlockSync, TraceEvGoBlockCond, TraceEvGoBlockNet,
+		TraceEvGoBlockGC:
+		return true
+	}
+	return false
+}
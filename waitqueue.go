@@ -0,0 +1,101 @@
+package gsysint
+
+import (
+	"unsafe"
+
+	"github.com/sitano/gsysint/g"
+)
+
+// WaitQueue is a FIFO queue of parked goroutines, the building block of
+// the synchronizers on top of the parking API. The waiters are chained
+// through their G.SchedLink, which the runtime only uses for runnable
+// goroutines, so waiting and waking do not allocate.
+//
+// A zero WaitQueue is empty and parks with g.WaitReasonZero.
+type WaitQueue struct {
+	lock       g.Mutex
+	head, tail g.Guintptr
+	n          int
+
+	reason  g.WaitReason
+	traceEv byte
+}
+
+// NewWaitQueue returns a WaitQueue parking its waiters with the
+// WithWaitReason and WithTraceEvent options. It panics on an invalid
+// option.
+func NewWaitQueue(opts ...ParkOption) *WaitQueue {
+	p := NewPark(opts...)
+	return &WaitQueue{reason: p.reason, traceEv: p.traceEv}
+}
+
+// Wait parks the current goroutine at the tail of q until WakeOne or
+// WakeAll readies it. It panics if the G layout failed g.Verify.
+func (q *WaitQueue) Wait() {
+	gp := g.CurG()
+	if gp == nil {
+		panic("gsysint: WaitQueue needs the G layout, it failed g.Verify")
+	}
+	lock(&q.lock)
+	gp.SchedLink = 0
+	if tail := q.tail.Ptr(); tail != nil {
+		tail.SchedLink.Set(gp)
+	} else {
+		q.head.Set(gp)
+	}
+	q.tail.Set(gp)
+	q.n++
+	// The wakers take the lock, goparkunlock releases it once gp is parked.
+	raceRelease(unsafe.Pointer(&q.lock))
+	GoParkUnlock(&q.lock, q.reason, q.traceEv, 1)
+	// The waker released gp before it readied it.
+	raceAcquire(unsafe.Pointer(gp))
+}
+
+// WakeOne readies the goroutine at the head of q, it reports false if q
+// is empty.
+func (q *WaitQueue) WakeOne() bool {
+	lock(&q.lock)
+	gp := q.head.Ptr()
+	if gp == nil {
+		unlock(&q.lock)
+		return false
+	}
+	q.head = gp.SchedLink
+	if q.head == 0 {
+		q.tail = 0
+	}
+	q.n--
+	unlock(&q.lock)
+
+	gp.SchedLink = 0
+	raceRelease(unsafe.Pointer(gp))
+	GoReady(gp, 1)
+	return true
+}
+
+// WakeAll readies all the goroutines of q in order and returns how many.
+func (q *WaitQueue) WakeAll() int {
+	lock(&q.lock)
+	gp, n := q.head.Ptr(), q.n
+	q.head, q.tail, q.n = 0, 0, 0
+	unlock(&q.lock)
+
+	for gp != nil {
+		// The runtime links runnable goroutines through SchedLink.
+		next := gp.SchedLink.Ptr()
+		gp.SchedLink = 0
+		raceRelease(unsafe.Pointer(gp))
+		GoReady(gp, 1)
+		gp = next
+	}
+	return n
+}
+
+// Len returns the number of goroutines waiting in q.
+func (q *WaitQueue) Len() int {
+	lock(&q.lock)
+	n := q.n
+	unlock(&q.lock)
+	return n
+}
@@ -0,0 +1,90 @@
+package gsysint
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sitano/gsysint/g"
+	"github.com/sitano/gsysint/trace"
+)
+
+// queued waits until q has n waiters.
+func queued(q *WaitQueue, n int) {
+	for q.Len() != n {
+		runtime.Gosched()
+	}
+}
+
+func TestWaitQueue(t *testing.T) {
+	q := NewWaitQueue(WithWaitReason(g.WaitReasonSyncCondWait), WithTraceEvent(trace.TraceEvGoBlockCond))
+	if q.WakeOne() {
+		t.Fatal("WakeOne woke a goroutine of an empty queue")
+	}
+
+	const n = 10
+	order := make(chan int, n)
+	var w sync.WaitGroup
+	for i := 0; i < n; i++ {
+		w.Add(1)
+		go func(i int) {
+			defer w.Done()
+			q.Wait()
+			order <- i
+		}(i)
+		// One by one for a known queue order.
+		queued(q, i+1)
+	}
+
+	for i := 0; i < n/2; i++ {
+		if !q.WakeOne() {
+			t.Fatalf("WakeOne found no goroutine in a queue of %d", q.Len())
+		}
+		if got := <-order; got != i {
+			t.Fatalf("woke goroutine %d, want %d", got, i)
+		}
+	}
+	if got := q.Len(); got != n-n/2 {
+		t.Fatalf("Len %d, want %d", got, n-n/2)
+	}
+	if got := q.WakeAll(); got != n-n/2 {
+		t.Fatalf("WakeAll woke %d, want %d", got, n-n/2)
+	}
+	w.Wait()
+	if q.Len() != 0 || q.WakeAll() != 0 {
+		t.Fatal("goroutines left in the queue")
+	}
+}
+
+func TestWaitQueueAllocs(t *testing.T) {
+	var q WaitQueue
+	var stop int32
+	done := make(chan struct{})
+	go func() {
+		for atomic.LoadInt32(&stop) == 0 {
+			q.Wait()
+		}
+		close(done)
+	}()
+
+	allocs := testing.AllocsPerRun(1000, func() {
+		queued(&q, 1)
+		q.WakeOne()
+	})
+	atomic.StoreInt32(&stop, 1)
+	for {
+		select {
+		case <-done:
+		default:
+			q.WakeOne()
+			runtime.Gosched()
+			continue
+		}
+		break
+	}
+
+	if allocs != 0 {
+		t.Fatalf("%v allocations per wait and wake", allocs)
+	}
+}
@@ -0,0 +1,14 @@
+//go:build go1.14
+// +build go1.14
+
+package gsysint
+
+import (
+	_ "unsafe"
+)
+
+// yield puts the current goroutine at the tail of the local run queue,
+// the P runs its runnext goroutine first.
+//
+//go:linkname yield runtime.goyield
+func yield()